@@ -0,0 +1,179 @@
+package money
+
+import (
+	"cmp"
+
+	"github.com/Opvra/go-money/internal/calc"
+)
+
+// GroupByCurrency sums the items per currency code.
+// Each group uses the first-seen Currency for its code; an item sharing the code
+// but not the scale or symbol returns ErrCurrencyMismatch.
+// Example: GroupByCurrency([]Money{New(100, USD), New(200, EUR), New(50, USD)}) -> {"USD": 150, "EUR": 200}.
+func GroupByCurrency(items []Money) (map[string]Money, error) {
+	out := make(map[string]Money)
+	for _, item := range items {
+		total, ok := out[item.currency.Code]
+		if !ok {
+			out[item.currency.Code] = item
+			continue
+		}
+		sum, err := total.Add(item)
+		if err != nil {
+			return nil, err
+		}
+		out[item.currency.Code] = sum
+	}
+	return out, nil
+}
+
+// SumConvert converts each item into target using rates keyed by source code, then sums.
+// Items already in target are added as-is. Each conversion is rounded to the
+// target scale before summing. A missing rate returns ErrMissingRate.
+// Example: SumConvert(GBP, map[string]ExchangeRate{"USD": usdToGBP}, New(10000, USD), New(1000, GBP)) -> New(8900, GBP).
+func SumConvert(target Currency, rates map[string]ExchangeRate, items ...Money) (Money, error) {
+	total := Zero(target)
+	for _, item := range items {
+		if !sameCurrency(item.currency, target) {
+			rate, ok := rates[item.currency.Code]
+			if !ok {
+				return Money{}, ErrMissingRate
+			}
+			if !sameCurrency(rate.To, target) {
+				return Money{}, ErrCurrencyMismatch
+			}
+			converted, err := item.Convert(rate)
+			if err != nil {
+				return Money{}, err
+			}
+			item = converted
+		}
+		sum, err := total.Add(item)
+		if err != nil {
+			return Money{}, err
+		}
+		total = sum
+	}
+	return total, nil
+}
+
+// SumScaled sums items that share target's code but may differ in scale, as
+// in legacy feeds. Each item is rescaled to target.Scale, rounding half to
+// even, before it is added; the total is in target. Another code returns
+// ErrCurrencyMismatch and overflow returns ErrInvalidOperation.
+// Example: SumScaled(USD, New(1050, USD), New(25055, USD4)) -> New(1301, USD).
+func SumScaled(target Currency, items ...Money) (Money, error) {
+	total := Zero(target)
+	for _, item := range items {
+		if item.currency.Code != target.Code {
+			return Money{}, ErrCurrencyMismatch
+		}
+		amount, err := item.AmountAtScale(target.Scale)
+		if err != nil {
+			return Money{}, err
+		}
+		sum, err := total.Add(Money{amount: amount, currency: target})
+		if err != nil {
+			return Money{}, err
+		}
+		total = sum
+	}
+	return total, nil
+}
+
+// WeightedAverage returns sum(items[i]*weights[i]) / sum(weights) in the
+// items' currency, computed exactly and rounded half to even to its scale.
+// The slices must be non-empty with equal lengths, weights non-negative with a
+// positive total, and items share one currency (else ErrCurrencyMismatch).
+// Example: WeightedAverage([]Money{New(1000, USD), New(1300, USD)}, []int64{1, 2}) -> New(1200, USD).
+func WeightedAverage(items []Money, weights []int64) (Money, error) {
+	if len(items) == 0 || len(items) != len(weights) {
+		return Money{}, ErrInvalidOperation
+	}
+	values := make([]int64, len(items))
+	for i, item := range items {
+		if !sameCurrency(item.currency, items[0].currency) {
+			return Money{}, ErrCurrencyMismatch
+		}
+		values[i] = item.amount
+	}
+	avg, err := calc.WeightedAverage(values, weights)
+	if err != nil {
+		return Money{}, ErrInvalidOperation
+	}
+	return Money{amount: avg, currency: items[0].currency}, nil
+}
+
+// SumPartial adds the items in order and, on failure, reports how far it got:
+// total is the running sum of items[:consumed] and items[consumed] is the item
+// that overflowed (ErrInvalidOperation) or mismatched (ErrCurrencyMismatch).
+// On success consumed is len(items). An empty slice returns ErrInvalidOperation.
+// Example: SumPartial(New(math.MaxInt64, USD), New(1, USD)) -> New(math.MaxInt64, USD), 1, ErrInvalidOperation.
+func SumPartial(items ...Money) (total Money, consumed int, err error) {
+	if len(items) == 0 {
+		return Money{}, 0, ErrInvalidOperation
+	}
+	total = items[0]
+	for i, item := range items[1:] {
+		sum, err := total.Add(item)
+		if err != nil {
+			return total, i + 1, err
+		}
+		total = sum
+	}
+	return total, len(items), nil
+}
+
+// Dedup returns items without later duplicates, keeping the first occurrence
+// of each value in order. Values are equal as in EqualForTest: same code, scale
+// and amount, ignoring the symbol. The input slice is not modified.
+// Example: Dedup([]Money{New(5, USD), New(7, USD), New(5, USD)}) -> [New(5, USD), New(7, USD)].
+func Dedup(items []Money) []Money {
+	seen := make(map[Key]struct{}, len(items))
+	out := make([]Money, 0, len(items))
+	for _, item := range items {
+		k := item.AsKey()
+		if _, ok := seen[k]; ok {
+			continue
+		}
+		seen[k] = struct{}{}
+		out = append(out, item)
+	}
+	return out
+}
+
+// CompareFunc orders Money by minor-unit amount, returning -1, 0 or +1.
+// It ignores currency entirely so it can be passed to slices.SortFunc; callers
+// must only use it on slices of a single currency.
+// Example: slices.SortFunc(items, CompareFunc).
+func CompareFunc(a, b Money) int {
+	return cmp.Compare(a.amount, b.amount)
+}
+
+// MinSlice returns the smallest item, requiring a non-empty, single-currency slice.
+// Example: MinSlice([]Money{New(5, USD), New(-1, USD)}) -> New(-1, USD).
+func MinSlice(items []Money) (Money, error) {
+	return pickSlice(items, -1)
+}
+
+// MaxSlice returns the largest item, requiring a non-empty, single-currency slice.
+// Example: MaxSlice([]Money{New(5, USD), New(-1, USD)}) -> New(5, USD).
+func MaxSlice(items []Money) (Money, error) {
+	return pickSlice(items, 1)
+}
+
+func pickSlice(items []Money, want int) (Money, error) {
+	if len(items) == 0 {
+		return Money{}, ErrInvalidOperation
+	}
+	best := items[0]
+	for _, item := range items[1:] {
+		if !sameCurrency(best.currency, item.currency) {
+			return Money{}, ErrCurrencyMismatch
+		}
+		if CompareFunc(item, best) == want {
+			best = item
+		}
+	}
+	return best, nil
+}
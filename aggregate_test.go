@@ -0,0 +1,230 @@
+package money
+
+import (
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestGroupByCurrency(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+	eur := Currency{Code: "EUR", Scale: 2, Symbol: "€"}
+
+	groups, err := GroupByCurrency([]Money{
+		New(1050, usd),
+		New(200, eur),
+		New(250, usd),
+		New(-50, eur),
+	})
+	if err != nil {
+		t.Fatalf("group error: %v", err)
+	}
+	if len(groups) != 2 {
+		t.Fatalf("groups = %v", groups)
+	}
+	if got := groups["USD"]; !got.Equal(New(1300, usd)) {
+		t.Fatalf("USD = %v", got)
+	}
+	if got := groups["EUR"]; !got.Equal(New(150, eur)) {
+		t.Fatalf("EUR = %v", got)
+	}
+}
+
+func TestGroupByCurrencyMismatch(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+	usd3 := Currency{Code: "USD", Scale: 3, Symbol: "$"}
+	_, err := GroupByCurrency([]Money{New(100, usd), New(100, usd3)})
+	if err != ErrCurrencyMismatch {
+		t.Fatalf("expected ErrCurrencyMismatch, got %v", err)
+	}
+}
+
+func TestSumConvert(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+	eur := Currency{Code: "EUR", Scale: 2, Symbol: "€"}
+	gbp := Currency{Code: "GBP", Scale: 2, Symbol: "£"}
+	rates := map[string]ExchangeRate{
+		"USD": {From: usd, To: gbp, Rate: 79, Scale: 2},
+		"EUR": {From: eur, To: gbp, Rate: 8601, Scale: 4},
+	}
+
+	total, err := SumConvert(gbp, rates, New(10000, usd), New(5000, eur), New(1000, gbp))
+	if err != nil {
+		t.Fatalf("sum convert: %v", err)
+	}
+	// 7900 + 4300.5 -> 4300 + 1000
+	if !total.Equal(New(13200, gbp)) {
+		t.Fatalf("total = %v", total)
+	}
+
+	jpy := Currency{Code: "JPY", Scale: 0, Symbol: "¥"}
+	if _, err := SumConvert(gbp, rates, New(10000, usd), New(100, jpy)); err != ErrMissingRate {
+		t.Fatalf("expected ErrMissingRate, got %v", err)
+	}
+}
+
+func TestSumScaled(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+	usd4 := Currency{Code: "USD", Scale: 4}
+	usd0 := Currency{Code: "USD", Scale: 0, Symbol: "US$"}
+	eur := Currency{Code: "EUR", Scale: 2, Symbol: "€"}
+
+	// 10.50 + 2.5055 (-> 2.51) + 2.5045 (-> 2.50) + 3 = 18.51
+	total, err := SumScaled(usd, New(1050, usd), New(25055, usd4), New(25045, usd4), New(3, usd0))
+	if err != nil {
+		t.Fatalf("sum scaled: %v", err)
+	}
+	if !total.Equal(New(1851, usd)) {
+		t.Fatalf("total = %v", total)
+	}
+
+	total, err = SumScaled(usd4, New(1050, usd), New(25055, usd4))
+	if err != nil || !total.Equal(New(130055, usd4)) {
+		t.Fatalf("total at scale 4 = %v, %v", total, err)
+	}
+	if total, err := SumScaled(usd); err != nil || !total.Equal(Zero(usd)) {
+		t.Fatalf("empty sum = %v, %v", total, err)
+	}
+
+	if _, err := SumScaled(usd, New(1050, usd), New(1050, eur)); err != ErrCurrencyMismatch {
+		t.Fatalf("expected ErrCurrencyMismatch, got %v", err)
+	}
+	if _, err := SumScaled(usd4, New(math.MaxInt64, usd)); err != ErrInvalidOperation {
+		t.Fatalf("expected ErrInvalidOperation, got %v", err)
+	}
+}
+
+func TestWeightedAverage(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+	eur := Currency{Code: "EUR", Scale: 2, Symbol: "€"}
+
+	tests := []struct {
+		amounts []int64
+		weights []int64
+		want    int64
+	}{
+		// 3 units at $10.00 and 1 at $13.50: 43.50 / 4 = 10.875.
+		{[]int64{1000, 1350}, []int64{3, 1}, 1088},
+		{[]int64{1001, 1002}, []int64{1, 1}, 1002},
+		{[]int64{1001, 1000}, []int64{1, 1}, 1000},
+		{[]int64{-1001, -1002}, []int64{1, 1}, -1002},
+		{[]int64{500, 9999}, []int64{1, 0}, 500},
+		{[]int64{math.MaxInt64, math.MaxInt64}, []int64{math.MaxInt64, 2}, math.MaxInt64},
+	}
+	for _, tt := range tests {
+		items := make([]Money, len(tt.amounts))
+		for i, a := range tt.amounts {
+			items[i] = New(a, usd)
+		}
+		got, err := WeightedAverage(items, tt.weights)
+		if err != nil {
+			t.Fatalf("weighted average %v %v: %v", tt.amounts, tt.weights, err)
+		}
+		if got.Amount() != tt.want || got.Currency() != usd {
+			t.Fatalf("weighted average %v %v = %v, want %d", tt.amounts, tt.weights, got, tt.want)
+		}
+	}
+
+	two := []Money{New(1000, usd), New(2000, usd)}
+	for _, weights := range [][]int64{{1}, {1, 2, 3}, {0, 0}, {2, -1}} {
+		if _, err := WeightedAverage(two, weights); err != ErrInvalidOperation {
+			t.Fatalf("weights %v: expected ErrInvalidOperation, got %v", weights, err)
+		}
+	}
+	if _, err := WeightedAverage(nil, nil); err != ErrInvalidOperation {
+		t.Fatalf("empty: expected ErrInvalidOperation, got %v", err)
+	}
+	if _, err := WeightedAverage([]Money{New(1, usd), New(1, eur)}, []int64{1, 1}); err != ErrCurrencyMismatch {
+		t.Fatalf("expected ErrCurrencyMismatch, got %v", err)
+	}
+}
+
+func TestSumPartial(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+	eur := Currency{Code: "EUR", Scale: 2, Symbol: "€"}
+	big := New(math.MaxInt64/2, usd)
+
+	total, consumed, err := SumPartial(New(1, usd), New(2, usd), New(3, usd))
+	if err != nil || consumed != 3 || total.Amount() != 6 {
+		t.Fatalf("sum = %d, %d, %v", total.Amount(), consumed, err)
+	}
+
+	total, consumed, err = SumPartial(New(-10, usd), big, big, big, New(1, usd))
+	if err != ErrInvalidOperation {
+		t.Fatalf("expected ErrInvalidOperation, got %v", err)
+	}
+	if consumed != 3 {
+		t.Fatalf("consumed = %d, want 3", consumed)
+	}
+	if want := 2*big.Amount() - 10; total.Amount() != want {
+		t.Fatalf("partial total = %d, want %d", total.Amount(), want)
+	}
+
+	total, consumed, err = SumPartial(New(5, usd), New(5, eur))
+	if err != ErrCurrencyMismatch || consumed != 1 || total.Amount() != 5 {
+		t.Fatalf("mismatch sum = %d, %d, %v", total.Amount(), consumed, err)
+	}
+
+	if _, _, err := SumPartial(); err != ErrInvalidOperation {
+		t.Fatalf("expected ErrInvalidOperation, got %v", err)
+	}
+}
+
+func TestDedup(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+	usdAlt := Currency{Code: "USD", Scale: 2, Symbol: "US$"}
+	usd3 := Currency{Code: "USD", Scale: 3, Symbol: "$"}
+	eur := Currency{Code: "EUR", Scale: 2, Symbol: "€"}
+
+	items := []Money{New(500, usd), New(700, usd), New(500, usdAlt), New(500, eur), New(500, usd3), New(700, usd)}
+	got := Dedup(items)
+	want := []Money{New(500, usd), New(700, usd), New(500, eur), New(500, usd3)}
+	if len(got) != len(want) {
+		t.Fatalf("dedup = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("dedup[%d] = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+	if items[2].Currency() != usdAlt || len(items) != 6 {
+		t.Fatalf("dedup modified its input")
+	}
+	if got := Dedup(nil); len(got) != 0 {
+		t.Fatalf("dedup(nil) = %v", got)
+	}
+}
+
+func TestCompareFuncSort(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+	items := []Money{New(500, usd), New(-200, usd), New(0, usd), New(500, usd), New(-1000, usd)}
+
+	slices.SortFunc(items, CompareFunc)
+	want := []int64{-1000, -200, 0, 500, 500}
+	for i, w := range want {
+		if items[i].Amount() != w {
+			t.Fatalf("sorted[%d] = %d, want %d", i, items[i].Amount(), w)
+		}
+	}
+
+	lo, err := MinSlice(items)
+	if err != nil || lo.Amount() != -1000 {
+		t.Fatalf("min = %d, %v", lo.Amount(), err)
+	}
+	hi, err := MaxSlice(items)
+	if err != nil || hi.Amount() != 500 {
+		t.Fatalf("max = %d, %v", hi.Amount(), err)
+	}
+}
+
+func TestMinMaxSliceErrors(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+	eur := Currency{Code: "EUR", Scale: 2, Symbol: "€"}
+
+	if _, err := MinSlice(nil); err != ErrInvalidOperation {
+		t.Fatalf("expected ErrInvalidOperation, got %v", err)
+	}
+	if _, err := MaxSlice([]Money{New(1, usd), New(2, eur)}); err != ErrCurrencyMismatch {
+		t.Fatalf("expected ErrCurrencyMismatch, got %v", err)
+	}
+}
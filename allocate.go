@@ -0,0 +1,131 @@
+package money
+
+import (
+	"cmp"
+	"math"
+	"slices"
+
+	"github.com/Opvra/go-money/internal/calc"
+)
+
+// Apportion applies m greedily to the targets in order, filling each target
+// before moving to the next, and returns the applied amount per target plus the
+// unapplied remainder. The payment and targets must be non-negative and share
+// the payment currency.
+// Example: New(1500, USD).Apportion(New(1000, USD), New(800, USD)) -> [1000, 500], 0.
+func (m Money) Apportion(targets ...Money) (applied []Money, remainder Money, err error) {
+	if m.amount < 0 {
+		return nil, Money{}, ErrInvalidOperation
+	}
+	applied = make([]Money, len(targets))
+	left := m.amount
+	for i, target := range targets {
+		if !sameCurrency(m.currency, target.currency) {
+			return nil, Money{}, ErrCurrencyMismatch
+		}
+		if target.amount < 0 {
+			return nil, Money{}, ErrInvalidOperation
+		}
+		part := min(left, target.amount)
+		applied[i] = Money{amount: part, currency: m.currency}
+		left -= part
+	}
+	return applied, Money{amount: left, currency: m.currency}, nil
+}
+
+// Allocate splits m across weights so the parts always sum to m.
+// Each part gets its truncated proportional share; leftover minor units go one
+// each to the weighted parts in order, starting with the first. Negative amounts
+// are split by magnitude and negated, so the first parts are the largest in
+// magnitude: -10.01 over three equal weights gives [-3.34, -3.34, -3.33].
+// Example: New(101, USD).Allocate(10, 45, 45) -> [11, 45, 45].
+func (m Money) Allocate(weights ...int) ([]Money, error) {
+	return m.allocate(weights, false)
+}
+
+// AllocateLargestRemainder splits m across weights using the largest remainder
+// (Hamilton) method: leftover minor units go to the parts whose exact shares had
+// the largest fractional remainders, ties favoring earlier parts.
+// Example: New(101, USD).AllocateLargestRemainder(10, 45, 45) -> [10, 46, 45].
+func (m Money) AllocateLargestRemainder(weights ...int) ([]Money, error) {
+	return m.allocate(weights, true)
+}
+
+// Split divides m into n parts that differ by at most one minor unit and sum to m,
+// with the larger-magnitude parts first; negatives follow Allocate.
+// Example: New(-1001, USD).Split(3) -> [-334, -334, -333].
+func (m Money) Split(n int) ([]Money, error) {
+	if n <= 0 {
+		return nil, ErrInvalidOperation
+	}
+	weights := make([]int, n)
+	for i := range weights {
+		weights[i] = 1
+	}
+	return m.allocate(weights, false)
+}
+
+// SplitDetailed is Split that also reports remainder, the number of leading
+// parts that received one extra minor unit (0..n-1). It always equals
+// |m.Amount() % n|, so callers can assert the distribution.
+// Example: New(-1001, USD).SplitDetailed(3) -> [-334, -334, -333], 2.
+func (m Money) SplitDetailed(n int) (parts []Money, remainder int64, err error) {
+	parts, err = m.Split(n)
+	if err != nil {
+		return nil, 0, err
+	}
+	last := parts[len(parts)-1].amount
+	for _, p := range parts {
+		if p.amount != last {
+			remainder++
+		}
+	}
+	return parts, remainder, nil
+}
+
+// MakeChange breaks m greedily into counts of the denominations, given in minor
+// units and tried largest first whatever their order, and returns what no
+// denomination can cover as remainder. Denominations with a zero count are
+// omitted from counts. The amount must be non-negative and every denomination
+// positive; a count that does not fit in int returns ErrInvalidOperation.
+// Example: New(118, USD).MakeChange([]int64{100, 25, 10, 5, 1}) -> {100: 1, 10: 1, 5: 1, 1: 3}, 0.
+func (m Money) MakeChange(denominations []int64) (counts map[int64]int, remainder Money, err error) {
+	if m.amount < 0 {
+		return nil, Money{}, ErrInvalidOperation
+	}
+	for _, d := range denominations {
+		if d <= 0 {
+			return nil, Money{}, ErrInvalidOperation
+		}
+	}
+	sorted := slices.Clone(denominations)
+	slices.SortFunc(sorted, func(a, b int64) int { return cmp.Compare(b, a) })
+	counts = make(map[int64]int)
+	left := m.amount
+	for _, d := range sorted {
+		if n := left / d; n > 0 {
+			if n > math.MaxInt {
+				return nil, Money{}, ErrInvalidOperation
+			}
+			counts[d] += int(n)
+			left -= n * d
+		}
+	}
+	return counts, Money{amount: left, currency: m.currency}, nil
+}
+
+func (m Money) allocate(weights []int, largestRemainder bool) ([]Money, error) {
+	ws := make([]int64, len(weights))
+	for i, w := range weights {
+		ws[i] = int64(w)
+	}
+	amounts, err := calc.Allocate(m.amount, ws, largestRemainder)
+	if err != nil {
+		return nil, ErrInvalidOperation
+	}
+	out := make([]Money, len(amounts))
+	for i, amount := range amounts {
+		out[i] = Money{amount: amount, currency: m.currency}
+	}
+	return out, nil
+}
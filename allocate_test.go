@@ -0,0 +1,249 @@
+package money
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestApportion(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+
+	applied, remainder, err := New(1500, usd).Apportion(New(1000, usd), New(800, usd), New(300, usd))
+	if err != nil {
+		t.Fatalf("apportion: %v", err)
+	}
+	want := []int64{1000, 500, 0}
+	for i, w := range want {
+		if applied[i].Amount() != w {
+			t.Fatalf("applied[%d] = %d, want %d", i, applied[i].Amount(), w)
+		}
+	}
+	if !remainder.IsZero() {
+		t.Fatalf("remainder = %d", remainder.Amount())
+	}
+
+	applied, remainder, err = New(2500, usd).Apportion(New(1000, usd), New(800, usd))
+	if err != nil {
+		t.Fatalf("apportion: %v", err)
+	}
+	if applied[0].Amount() != 1000 || applied[1].Amount() != 800 || remainder.Amount() != 700 {
+		t.Fatalf("applied = %v, remainder = %v", applied, remainder)
+	}
+}
+
+func TestApportionErrors(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+	eur := Currency{Code: "EUR", Scale: 2, Symbol: "€"}
+
+	if _, _, err := New(100, usd).Apportion(New(100, eur)); err != ErrCurrencyMismatch {
+		t.Fatalf("expected ErrCurrencyMismatch, got %v", err)
+	}
+	if _, _, err := New(-100, usd).Apportion(New(100, usd)); err != ErrInvalidOperation {
+		t.Fatalf("expected ErrInvalidOperation, got %v", err)
+	}
+	if _, _, err := New(100, usd).Apportion(New(-100, usd)); err != ErrInvalidOperation {
+		t.Fatalf("expected ErrInvalidOperation, got %v", err)
+	}
+}
+
+func TestAllocateLargestRemainder(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+	m := New(101, usd)
+
+	plain, err := m.Allocate(10, 45, 45)
+	if err != nil {
+		t.Fatalf("allocate: %v", err)
+	}
+	hamilton, err := m.AllocateLargestRemainder(10, 45, 45)
+	if err != nil {
+		t.Fatalf("allocate largest remainder: %v", err)
+	}
+
+	assertAmounts(t, "plain", plain, []int64{11, 45, 45})
+	assertAmounts(t, "largest remainder", hamilton, []int64{10, 46, 45})
+	assertSum(t, plain, m)
+	assertSum(t, hamilton, m)
+}
+
+func TestAllocateSumInvariant(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+	weights := [][]int{{1}, {1, 1, 1}, {3, 0, 7}, {1, 2, 3, 4, 5}, {100, 1}, {1 << 40, 3}}
+	for _, amount := range []int64{0, 1, 7, 100, 12345, math.MaxInt64, math.MinInt64} {
+		m := New(amount, usd)
+		for _, w := range weights {
+			for _, lr := range []bool{false, true} {
+				parts, err := m.allocate(w, lr)
+				if err != nil {
+					t.Fatalf("allocate %d %v: %v", amount, w, err)
+				}
+				assertSum(t, parts, m)
+			}
+		}
+	}
+}
+
+func TestSplit(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+
+	tests := []struct {
+		amount int64
+		n      int
+		want   []int64
+	}{
+		{1001, 3, []int64{334, 334, 333}},
+		{-1001, 3, []int64{-334, -334, -333}},
+		{-2, 3, []int64{-1, -1, 0}},
+		{100, 1, []int64{100}},
+		{0, 2, []int64{0, 0}},
+	}
+	for _, tt := range tests {
+		m := New(tt.amount, usd)
+		parts, err := m.Split(tt.n)
+		if err != nil {
+			t.Fatalf("split %d by %d: %v", tt.amount, tt.n, err)
+		}
+		assertAmounts(t, "split", parts, tt.want)
+		assertSum(t, parts, m)
+	}
+
+	for _, n := range []int{0, -1} {
+		if _, err := New(100, usd).Split(n); err != ErrInvalidOperation {
+			t.Fatalf("split by %d: expected ErrInvalidOperation, got %v", n, err)
+		}
+	}
+}
+
+func TestSplitDetailed(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+
+	tests := []struct {
+		amount int64
+		n      int
+	}{
+		{1001, 3},
+		{-1001, 3},
+		{1000, 4},
+		{999, 7},
+		{-2, 3},
+		{5, 10},
+		{math.MaxInt64, 6},
+		{math.MinInt64, 7},
+	}
+	for _, tt := range tests {
+		m := New(tt.amount, usd)
+		parts, remainder, err := m.SplitDetailed(tt.n)
+		if err != nil {
+			t.Fatalf("split %d by %d: %v", tt.amount, tt.n, err)
+		}
+		want := tt.amount % int64(tt.n)
+		if want < 0 {
+			want = -want
+		}
+		if remainder != want {
+			t.Fatalf("split %d by %d remainder = %d, want %d", tt.amount, tt.n, remainder, want)
+		}
+		plain, _ := m.Split(tt.n)
+		for i := range plain {
+			if !parts[i].Equal(plain[i]) {
+				t.Fatalf("split %d by %d part %d = %v, want %v", tt.amount, tt.n, i, parts[i], plain[i])
+			}
+		}
+		assertSum(t, parts, m)
+	}
+
+	if _, _, err := New(100, usd).SplitDetailed(0); err != ErrInvalidOperation {
+		t.Fatalf("expected ErrInvalidOperation, got %v", err)
+	}
+}
+
+func TestMakeChange(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+
+	counts, remainder, err := New(118, usd).MakeChange([]int64{100, 25, 10, 5, 1})
+	if err != nil {
+		t.Fatalf("make change: %v", err)
+	}
+	want := map[int64]int{100: 1, 10: 1, 5: 1, 1: 3}
+	if !reflect.DeepEqual(counts, want) || !remainder.IsZero() {
+		t.Fatalf("make change = %v, %v, want %v, 0", counts, remainder, want)
+	}
+
+	counts, remainder, err = New(118, usd).MakeChange([]int64{5, 25})
+	if err != nil {
+		t.Fatalf("make change: %v", err)
+	}
+	want = map[int64]int{25: 4, 5: 3}
+	if !reflect.DeepEqual(counts, want) || remainder.Amount() != 3 || remainder.Currency() != usd {
+		t.Fatalf("make change = %v, %v, want %v, 3", counts, remainder, want)
+	}
+
+	for _, tt := range []struct {
+		amount        int64
+		denominations []int64
+	}{
+		{-1, []int64{1}},
+		{100, []int64{25, 0}},
+		{100, []int64{-5}},
+	} {
+		if _, _, err := New(tt.amount, usd).MakeChange(tt.denominations); err != ErrInvalidOperation {
+			t.Fatalf("make change %d %v: expected ErrInvalidOperation, got %v", tt.amount, tt.denominations, err)
+		}
+	}
+}
+
+func TestAllocateNegative(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+	m := New(-101, usd)
+
+	plain, err := m.Allocate(10, 45, 45)
+	if err != nil {
+		t.Fatalf("allocate: %v", err)
+	}
+	hamilton, err := m.AllocateLargestRemainder(10, 45, 45)
+	if err != nil {
+		t.Fatalf("allocate largest remainder: %v", err)
+	}
+	even, err := New(-1001, usd).Allocate(1, 1, 1)
+	if err != nil {
+		t.Fatalf("allocate: %v", err)
+	}
+
+	assertAmounts(t, "plain", plain, []int64{-11, -45, -45})
+	assertAmounts(t, "largest remainder", hamilton, []int64{-10, -46, -45})
+	assertAmounts(t, "even", even, []int64{-334, -334, -333})
+	assertSum(t, plain, m)
+	assertSum(t, hamilton, m)
+}
+
+func TestAllocateErrors(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+	for _, w := range [][]int{nil, {0, 0}, {1, -1}} {
+		if _, err := New(100, usd).Allocate(w...); err != ErrInvalidOperation {
+			t.Fatalf("allocate %v: expected ErrInvalidOperation, got %v", w, err)
+		}
+	}
+}
+
+func assertAmounts(t *testing.T, name string, parts []Money, want []int64) {
+	t.Helper()
+	if len(parts) != len(want) {
+		t.Fatalf("%s: got %d parts, want %d", name, len(parts), len(want))
+	}
+	for i, w := range want {
+		if parts[i].Amount() != w {
+			t.Fatalf("%s[%d] = %d, want %d", name, i, parts[i].Amount(), w)
+		}
+	}
+}
+
+func assertSum(t *testing.T, parts []Money, total Money) {
+	t.Helper()
+	var sum int64
+	for _, p := range parts {
+		sum += p.Amount()
+	}
+	if sum != total.Amount() {
+		t.Fatalf("parts %v sum to %d, want %d", parts, sum, total.Amount())
+	}
+}
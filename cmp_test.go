@@ -0,0 +1,33 @@
+package money
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestEqualForTestWithCmp(t *testing.T) {
+	type line struct {
+		Name  string
+		Price Money
+	}
+
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+	usdPlain := Currency{Code: "USD", Scale: 2}
+
+	want := []line{{Name: "a", Price: New(1050, usd)}, {Name: "b", Price: New(-1, usd)}}
+	got := []line{{Name: "a", Price: New(1050, usdPlain)}, {Name: "b", Price: New(-1, usdPlain)}}
+	if diff := cmp.Diff(want, got, cmp.Comparer(EqualForTest)); diff != "" {
+		t.Fatalf("unexpected diff (-want +got):\n%s", diff)
+	}
+
+	got[1].Price = New(-2, usdPlain)
+	if diff := cmp.Diff(want, got, cmp.Comparer(EqualForTest)); diff == "" {
+		t.Fatalf("expected a diff for differing amounts")
+	}
+
+	usd3 := Currency{Code: "USD", Scale: 3, Symbol: "$"}
+	if EqualForTest(New(1050, usd), New(1050, usd3)) {
+		t.Fatalf("expected scale to matter")
+	}
+}
@@ -1,9 +1,61 @@
 package money
 
+import (
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/Opvra/go-money/internal/calc"
+)
+
+// maxCurrencyScale is the largest scale whose minor-unit factor fits in int64.
+const maxCurrencyScale = 18
+
 // Currency defines an ISO-4217 currency and its decimal scale.
+// MajorUnitName and MinorUnitName are optional singular English unit names used by SpellOut.
 // Example: Currency{Code: "USD", Scale: 2, Symbol: "$"}.
 type Currency struct {
-	Code   string
-	Scale  int32
-	Symbol string
+	Code          string
+	Scale         int32
+	Symbol        string
+	MajorUnitName string
+	MinorUnitName string
+}
+
+// IsZeroDecimal reports whether the currency has no minor unit (scale 0).
+// Example: JPY.IsZeroDecimal() -> true.
+func (c Currency) IsZeroDecimal() bool {
+	return c.Scale == 0
+}
+
+// MinorUnitsPerMajor returns 10^Scale, or 0 when the scale is negative or the
+// power does not fit in int64.
+// Example: USD.MinorUnitsPerMajor() -> 100.
+func (c Currency) MinorUnitsPerMajor() int64 {
+	v, ok := calc.Pow10(c.Scale)
+	if !ok {
+		return 0
+	}
+	return v
+}
+
+// Validate reports whether the currency is well formed: a three-letter
+// uppercase ASCII code, a scale in [0, 18] and a valid UTF-8 symbol.
+// The returned error describes the problem and wraps ErrInvalidOperation.
+// Example: Currency{Code: "usd", Scale: 2}.Validate() -> error.
+func (c Currency) Validate() error {
+	if len(c.Code) != 3 {
+		return fmt.Errorf("currency code %q: want three letters: %w", c.Code, ErrInvalidOperation)
+	}
+	for i := 0; i < len(c.Code); i++ {
+		if c.Code[i] < 'A' || c.Code[i] > 'Z' {
+			return fmt.Errorf("currency code %q: want uppercase A-Z: %w", c.Code, ErrInvalidOperation)
+		}
+	}
+	if c.Scale < 0 || c.Scale > maxCurrencyScale {
+		return fmt.Errorf("currency %s: scale %d outside [0, %d]: %w", c.Code, c.Scale, maxCurrencyScale, ErrInvalidOperation)
+	}
+	if !utf8.ValidString(c.Symbol) {
+		return fmt.Errorf("currency %s: symbol is not valid UTF-8: %w", c.Code, ErrInvalidOperation)
+	}
+	return nil
 }
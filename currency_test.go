@@ -0,0 +1,55 @@
+package money
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCurrencyScaleHelpers(t *testing.T) {
+	tests := []struct {
+		currency    Currency
+		zeroDecimal bool
+		perMajor    int64
+	}{
+		{Currency{Code: "JPY", Scale: 0, Symbol: "¥"}, true, 1},
+		{Currency{Code: "USD", Scale: 2, Symbol: "$"}, false, 100},
+		{Currency{Code: "BHD", Scale: 3, Symbol: "BD"}, false, 1000},
+		{Currency{Code: "XXX", Scale: 19}, false, 0},
+		{Currency{Code: "XXX", Scale: -1}, false, 0},
+	}
+	for _, tt := range tests {
+		if got := tt.currency.IsZeroDecimal(); got != tt.zeroDecimal {
+			t.Fatalf("%s IsZeroDecimal = %v, want %v", tt.currency.Code, got, tt.zeroDecimal)
+		}
+		if got := tt.currency.MinorUnitsPerMajor(); got != tt.perMajor {
+			t.Fatalf("%s MinorUnitsPerMajor = %d, want %d", tt.currency.Code, got, tt.perMajor)
+		}
+	}
+}
+
+func TestCurrencyValidate(t *testing.T) {
+	valid := []Currency{
+		{Code: "USD", Scale: 2, Symbol: "$"},
+		{Code: "JPY", Scale: 0, Symbol: "¥"},
+		{Code: "XTS", Scale: 18},
+	}
+	for _, c := range valid {
+		if err := c.Validate(); err != nil {
+			t.Fatalf("%+v: unexpected error %v", c, err)
+		}
+	}
+
+	invalid := []Currency{
+		{Code: "", Scale: 2},
+		{Code: "usd", Scale: 2},
+		{Code: "USDT", Scale: 2},
+		{Code: "USD", Scale: -1},
+		{Code: "USD", Scale: 19},
+		{Code: "USD", Scale: 2, Symbol: "\xff"},
+	}
+	for _, c := range invalid {
+		if err := c.Validate(); !errors.Is(err, ErrInvalidOperation) {
+			t.Fatalf("%+v: expected ErrInvalidOperation, got %v", c, err)
+		}
+	}
+}
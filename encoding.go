@@ -0,0 +1,197 @@
+package money
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+)
+
+// encodingVersion is written by the gob and JSON encoders. Decoders treat a
+// missing version as 1 and reject versions they do not know.
+const encodingVersion = 1
+
+// moneyGob is the exported-field mirror of Money used by gob encoding.
+type moneyGob struct {
+	Version  int
+	Amount   int64
+	Currency Currency
+}
+
+// checkVersion accepts payloads from encoders up to encodingVersion; 0 means a
+// legacy payload written before versioning, which is version 1.
+// Example: checkVersion(2) -> error.
+func checkVersion(v int) error {
+	switch v {
+	case 0, 1:
+		return nil
+	default:
+		return fmt.Errorf("unsupported encoding version %d: %w", v, ErrInvalidOperation)
+	}
+}
+
+// GobEncode implements gob.GobEncoder so Money can be embedded in gob-encoded structs.
+// Example: gob.NewEncoder(w).Encode(struct{ Price Money }{New(1050, USD)}).
+func (m Money) GobEncode() ([]byte, error) {
+	buf := &bytes.Buffer{}
+	if err := gob.NewEncoder(buf).Encode(moneyGob{Version: encodingVersion, Amount: m.amount, Currency: m.currency}); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// GobDecode implements gob.GobDecoder for values produced by GobEncode.
+// Example: gob.NewDecoder(r).Decode(&dst) restores the amount and currency.
+func (m *Money) GobDecode(data []byte) error {
+	var v moneyGob
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&v); err != nil {
+		return err
+	}
+	if err := checkVersion(v.Version); err != nil {
+		return err
+	}
+	*m = Money{amount: v.Amount, currency: v.Currency}
+	return nil
+}
+
+// DecimalJSON marshals Money as a decimal-string amount plus currency code,
+// e.g. {"v":1,"amount":"10.50","currency":"USD"}, avoiding float precision loss in
+// JavaScript clients. Payloads without "v" are read as version 1.
+//
+// The payload carries only the code, so decoding reuses the destination's
+// currency when it already has that code, keeping its scale, symbol and unit
+// names; otherwise it resolves the code via the registry, returning
+// ErrUnknownCurrency for unregistered codes. Decoding into a zero DecimalJSON
+// therefore round-trips only registered currencies, with registry symbols.
+// The amount is parsed with Parse, so "10.5" is zero-padded to 1050 for a
+// scale-2 currency.
+// Example: json.Marshal(DecimalJSON(New(1050, USD))) -> {"v":1,"amount":"10.50","currency":"USD"}.
+type DecimalJSON Money
+
+type decimalJSON struct {
+	Version  int    `json:"v,omitempty"`
+	Amount   string `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (d DecimalJSON) MarshalJSON() ([]byte, error) {
+	m := Money(d)
+	return json.Marshal(decimalJSON{Version: encodingVersion, Amount: plainAmount(m), Currency: m.currency.Code})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (d *DecimalJSON) UnmarshalJSON(data []byte) error {
+	var v decimalJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	if err := checkVersion(v.Version); err != nil {
+		return err
+	}
+	currency := d.currency
+	if currency.Code != v.Currency {
+		var ok bool
+		if currency, ok = LookupCurrency(v.Currency); !ok {
+			return ErrUnknownCurrency
+		}
+	}
+	m, err := Parse(v.Amount, currency)
+	if err != nil {
+		return err
+	}
+	*d = DecimalJSON(m)
+	return nil
+}
+
+var symbolPositionNames = map[SymbolPosition]string{
+	SymbolPrefix: "prefix",
+	SymbolSuffix: "suffix",
+}
+
+var symbolKindNames = map[SymbolKind]string{
+	SymbolUseCurrencySymbol: "symbol",
+	SymbolUseCurrencyCode:   "code",
+	SymbolUseCustom:         "custom",
+	SymbolUseSymbolOrCode:   "symbolorcode",
+}
+
+var numeralSystemNames = map[NumeralSystem]string{
+	NumeralsLatin:         "latin",
+	NumeralsEasternArabic: "arabic",
+	NumeralsDevanagari:    "devanagari",
+}
+
+var codeCaseNames = map[CodeCase]string{
+	CodeAsIs:  "asis",
+	CodeUpper: "upper",
+	CodeLower: "lower",
+}
+
+// MarshalJSON implements json.Marshaler using the names "prefix" and "suffix".
+// Example: json.Marshal(SymbolSuffix) -> "suffix".
+func (p SymbolPosition) MarshalJSON() ([]byte, error) {
+	return marshalEnum(p, symbolPositionNames, "symbol position")
+}
+
+// UnmarshalJSON implements json.Unmarshaler; unknown names return ErrInvalidOperation.
+func (p *SymbolPosition) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, p, symbolPositionNames, "symbol position")
+}
+
+// MarshalJSON implements json.Marshaler using the names "symbol", "code",
+// "custom" and "symbolorcode".
+// Example: json.Marshal(SymbolUseCurrencyCode) -> "code".
+func (k SymbolKind) MarshalJSON() ([]byte, error) {
+	return marshalEnum(k, symbolKindNames, "symbol kind")
+}
+
+// UnmarshalJSON implements json.Unmarshaler; unknown names return ErrInvalidOperation.
+func (k *SymbolKind) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, k, symbolKindNames, "symbol kind")
+}
+
+// MarshalJSON implements json.Marshaler using the names "latin", "arabic" and
+// "devanagari".
+// Example: json.Marshal(NumeralsDevanagari) -> "devanagari".
+func (n NumeralSystem) MarshalJSON() ([]byte, error) {
+	return marshalEnum(n, numeralSystemNames, "numeral system")
+}
+
+// UnmarshalJSON implements json.Unmarshaler; unknown names return ErrInvalidOperation.
+func (n *NumeralSystem) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, n, numeralSystemNames, "numeral system")
+}
+
+// MarshalJSON implements json.Marshaler using the names "asis", "upper" and "lower".
+// Example: json.Marshal(CodeLower) -> "lower".
+func (c CodeCase) MarshalJSON() ([]byte, error) {
+	return marshalEnum(c, codeCaseNames, "code case")
+}
+
+// UnmarshalJSON implements json.Unmarshaler; unknown names return ErrInvalidOperation.
+func (c *CodeCase) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, c, codeCaseNames, "code case")
+}
+
+func marshalEnum[T comparable](v T, names map[T]string, what string) ([]byte, error) {
+	name, ok := names[v]
+	if !ok {
+		return nil, fmt.Errorf("unknown %s %v: %w", what, v, ErrInvalidOperation)
+	}
+	return json.Marshal(name)
+}
+
+func unmarshalEnum[T comparable](data []byte, dst *T, names map[T]string, what string) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		return err
+	}
+	for v, n := range names {
+		if n == name {
+			*dst = v
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown %s %q: %w", what, name, ErrInvalidOperation)
+}
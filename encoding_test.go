@@ -0,0 +1,250 @@
+package money
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestGobRoundTrip(t *testing.T) {
+	type invoice struct {
+		Total    Money
+		Currency Currency
+		Lines    []Money
+	}
+
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+	in := invoice{
+		Total:    New(-1050, usd),
+		Currency: usd,
+		Lines:    []Money{New(1000, usd), New(50, usd)},
+	}
+
+	buf := &bytes.Buffer{}
+	if err := gob.NewEncoder(buf).Encode(in); err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+	var out invoice
+	if err := gob.NewDecoder(buf).Decode(&out); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+
+	if !out.Total.Equal(in.Total) {
+		t.Fatalf("total = %v, want %v", out.Total, in.Total)
+	}
+	if out.Currency != usd {
+		t.Fatalf("currency = %+v", out.Currency)
+	}
+	if len(out.Lines) != 2 || !out.Lines[0].Equal(in.Lines[0]) || !out.Lines[1].Equal(in.Lines[1]) {
+		t.Fatalf("lines = %v", out.Lines)
+	}
+}
+
+func TestDecimalJSONRoundTrip(t *testing.T) {
+	usd, _ := LookupCurrency("USD")
+	jpy, _ := LookupCurrency("JPY")
+
+	tests := []struct {
+		m    Money
+		want string
+	}{
+		{New(1050, usd), `{"v":1,"amount":"10.50","currency":"USD"}`},
+		{New(-5, usd), `{"v":1,"amount":"-0.05","currency":"USD"}`},
+		{New(123, jpy), `{"v":1,"amount":"123","currency":"JPY"}`},
+	}
+	for _, tt := range tests {
+		data, err := json.Marshal(DecimalJSON(tt.m))
+		if err != nil {
+			t.Fatalf("marshal: %v", err)
+		}
+		if string(data) != tt.want {
+			t.Fatalf("marshal = %s, want %s", data, tt.want)
+		}
+		var back DecimalJSON
+		if err := json.Unmarshal(data, &back); err != nil {
+			t.Fatalf("unmarshal %s: %v", data, err)
+		}
+		if !Money(back).Equal(tt.m) {
+			t.Fatalf("round trip %s -> %v", data, Money(back))
+		}
+	}
+}
+
+func TestDecimalJSONDestinationCurrency(t *testing.T) {
+	xts := Currency{Code: "XTS", Scale: 4, Symbol: "T", MajorUnitName: "token"}
+	usd, _ := LookupCurrency("USD")
+	custom := usd
+	custom.Symbol = "US$"
+
+	for _, m := range []Money{New(123456, xts), New(1050, custom)} {
+		data, err := json.Marshal(DecimalJSON(m))
+		if err != nil {
+			t.Fatalf("marshal: %v", err)
+		}
+		back := DecimalJSON(Zero(m.Currency()))
+		if err := json.Unmarshal(data, &back); err != nil {
+			t.Fatalf("unmarshal %s: %v", data, err)
+		}
+		if Money(back) != m {
+			t.Fatalf("round trip %s -> %+v, want %+v", data, Money(back), m)
+		}
+	}
+
+	data, _ := json.Marshal(DecimalJSON(New(123456, xts)))
+	var zero DecimalJSON
+	if err := json.Unmarshal(data, &zero); err != ErrUnknownCurrency {
+		t.Fatalf("unregistered into zero value: expected ErrUnknownCurrency, got %v", err)
+	}
+	data, _ = json.Marshal(DecimalJSON(New(1050, custom)))
+	other := DecimalJSON(Zero(xts))
+	if err := json.Unmarshal(data, &other); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if Money(other) != New(1050, usd) {
+		t.Fatalf("registered code = %+v, want registry currency", Money(other))
+	}
+}
+
+func TestEncodingVersions(t *testing.T) {
+	usd, _ := LookupCurrency("USD")
+
+	for _, data := range []string{
+		`{"amount":"10.50","currency":"USD"}`,
+		`{"v":1,"amount":"10.50","currency":"USD"}`,
+	} {
+		var d DecimalJSON
+		if err := json.Unmarshal([]byte(data), &d); err != nil {
+			t.Fatalf("unmarshal %s: %v", data, err)
+		}
+		if !Money(d).Equal(New(1050, usd)) {
+			t.Fatalf("unmarshal %s = %v", data, Money(d))
+		}
+	}
+	var d DecimalJSON
+	if err := json.Unmarshal([]byte(`{"v":2,"amount":"10.50","currency":"USD"}`), &d); !errors.Is(err, ErrInvalidOperation) {
+		t.Fatalf("expected ErrInvalidOperation for v2, got %v", err)
+	}
+
+	// A legacy gob payload has no Version field.
+	type legacyMoneyGob struct {
+		Amount   int64
+		Currency Currency
+	}
+	encode := func(v any) []byte {
+		t.Helper()
+		buf := &bytes.Buffer{}
+		if err := gob.NewEncoder(buf).Encode(v); err != nil {
+			t.Fatalf("encode: %v", err)
+		}
+		return buf.Bytes()
+	}
+	for _, payload := range [][]byte{
+		encode(legacyMoneyGob{Amount: -1050, Currency: usd}),
+		encode(moneyGob{Version: 1, Amount: -1050, Currency: usd}),
+	} {
+		var m Money
+		if err := m.GobDecode(payload); err != nil {
+			t.Fatalf("gob decode: %v", err)
+		}
+		if !m.Equal(New(-1050, usd)) {
+			t.Fatalf("gob decode = %v", m)
+		}
+	}
+	var m Money
+	if err := m.GobDecode(encode(moneyGob{Version: 2, Amount: 1, Currency: usd})); !errors.Is(err, ErrInvalidOperation) {
+		t.Fatalf("expected ErrInvalidOperation for gob v2, got %v", err)
+	}
+}
+
+func TestDecimalJSONUnmarshal(t *testing.T) {
+	var d DecimalJSON
+	if err := json.Unmarshal([]byte(`{"amount":"10.5","currency":"USD"}`), &d); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if got := Money(d).Amount(); got != 1050 {
+		t.Fatalf("padded amount = %d", got)
+	}
+
+	tests := []struct {
+		data string
+		err  error
+	}{
+		{`{"amount":"10.505","currency":"USD"}`, ErrInvalidOperation},
+		{`{"amount":10.50,"currency":"USD"}`, nil},
+		{`{"amount":"10.50","currency":"XXQ"}`, ErrUnknownCurrency},
+	}
+	for _, tt := range tests {
+		err := json.Unmarshal([]byte(tt.data), &d)
+		if err == nil {
+			t.Fatalf("unmarshal %s: expected error", tt.data)
+		}
+		if tt.err != nil && err != tt.err {
+			t.Fatalf("unmarshal %s error = %v, want %v", tt.data, err, tt.err)
+		}
+	}
+}
+
+func TestFormatConfigJSONRoundTrip(t *testing.T) {
+	cfg := FormatConfig{
+		DecimalSeparator:   ",",
+		ThousandsSeparator: ".",
+		SymbolPosition:     SymbolSuffix,
+		SymbolKind:         SymbolUseCustom,
+		CustomSymbol:       "EUR€",
+		Space:              true,
+		NegativeParens:     true,
+		MinIntegerDigits:   2,
+		TrimTrailingZeros:  true,
+		MinFractionDigits:  1,
+		MaxFractionDigits:  2,
+		ZeroText:           "free",
+		NegativePrefix:     "\x1b[31m",
+		NegativeSuffix:     "\x1b[0m",
+		RTL:                true,
+		NumeralSystem:      NumeralsEasternArabic,
+		CodeCase:           CodeUpper,
+	}
+	data, err := json.Marshal(cfg)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	if !strings.Contains(string(data), `"SymbolPosition":"suffix"`) || !strings.Contains(string(data), `"SymbolKind":"custom"`) || !strings.Contains(string(data), `"NumeralSystem":"arabic"`) {
+		t.Fatalf("enums not named in %s", data)
+	}
+	var back FormatConfig
+	if err := json.Unmarshal(data, &back); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if back != cfg {
+		t.Fatalf("round trip = %+v, want %+v", back, cfg)
+	}
+
+	for _, kind := range []SymbolKind{SymbolUseCurrencySymbol, SymbolUseCurrencyCode, SymbolUseSymbolOrCode} {
+		data, err := json.Marshal(kind)
+		if err != nil {
+			t.Fatalf("marshal %d: %v", kind, err)
+		}
+		var got SymbolKind
+		if err := json.Unmarshal(data, &got); err != nil || got != kind {
+			t.Fatalf("kind round trip %s = %d, %v", data, got, err)
+		}
+	}
+}
+
+func TestFormatConfigJSONErrors(t *testing.T) {
+	var cfg FormatConfig
+	for _, data := range []string{`{"SymbolPosition":"middle"}`, `{"SymbolKind":"emoji"}`, `{"SymbolKind":1}`} {
+		if err := json.Unmarshal([]byte(data), &cfg); err == nil {
+			t.Fatalf("unmarshal %s: expected error", data)
+		}
+	}
+	if err := json.Unmarshal([]byte(`{"SymbolPosition":"middle"}`), &cfg); !errors.Is(err, ErrInvalidOperation) {
+		t.Fatalf("expected ErrInvalidOperation, got %v", err)
+	}
+	if _, err := json.Marshal(SymbolPosition(7)); !errors.Is(err, ErrInvalidOperation) {
+		t.Fatalf("expected ErrInvalidOperation, got %v", err)
+	}
+}
@@ -1,6 +1,9 @@
 package money
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 var (
 	// ErrCurrencyMismatch is returned when Money values use different currencies.
@@ -9,4 +12,16 @@ var (
 	// ErrInvalidOperation is returned when an operation cannot be performed safely.
 	// Example: overflow or invalid format configuration -> ErrInvalidOperation.
 	ErrInvalidOperation = errors.New("invalid operation")
+	// ErrDivideByZero is returned when a divisor is zero; it wraps ErrInvalidOperation.
+	// Example: New(100, USD).Div(0) -> ErrDivideByZero.
+	ErrDivideByZero = fmt.Errorf("divide by zero: %w", ErrInvalidOperation)
+	// ErrUnknownCurrency is returned when a code is not in the registry; it wraps ErrInvalidOperation.
+	// Example: ParseCompact("XXQ10") -> ErrUnknownCurrency.
+	ErrUnknownCurrency = fmt.Errorf("unknown currency: %w", ErrInvalidOperation)
+	// ErrMissingRate is returned when no exchange rate exists for a currency; it wraps ErrInvalidOperation.
+	// Example: SumConvert(GBP, map[string]ExchangeRate{}, New(100, USD)) -> ErrMissingRate.
+	ErrMissingRate = fmt.Errorf("missing exchange rate: %w", ErrInvalidOperation)
+	// ErrFormatLocked is returned when the global format changes after LockFormat; it wraps ErrInvalidOperation.
+	// Example: LockFormat(); SetFormat(EUFormat()) -> ErrFormatLocked.
+	ErrFormatLocked = fmt.Errorf("format config is locked: %w", ErrInvalidOperation)
 )
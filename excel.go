@@ -0,0 +1,37 @@
+package money
+
+import (
+	"strconv"
+	"strings"
+)
+
+// ExcelValue returns the major-unit amount as a float64 together with an Excel
+// number-format code, for spreadsheet writers that need a numeric cell.
+// The float is the nearest float64 to the exact decimal and is lossy for large
+// amounts or high scales; use it only at the export boundary, never for arithmetic.
+// The symbol falls back to the currency code when empty; "$" is left unquoted.
+// Example: New(123456, USD).ExcelValue() -> 1234.56, "$#,##0.00".
+func (m Money) ExcelValue() (float64, string) {
+	value, err := strconv.ParseFloat(plainAmount(m), 64)
+	if err != nil {
+		value = 0
+	}
+	return value, excelNumberFormat(m.currency)
+}
+
+// excelNumberFormat builds a grouped number format with one placeholder per fraction digit.
+// Example: excelNumberFormat(JPY) -> "\"¥\"#,##0".
+func excelNumberFormat(c Currency) string {
+	symbol := c.Symbol
+	if symbol == "" {
+		symbol = c.Code
+	}
+	if symbol != "" && symbol != "$" {
+		symbol = `"` + strings.ReplaceAll(symbol, `"`, "") + `"`
+	}
+	format := symbol + "#,##0"
+	if c.Scale > 0 {
+		format += "." + strings.Repeat("0", int(c.Scale))
+	}
+	return format
+}
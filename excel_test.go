@@ -0,0 +1,26 @@
+package money
+
+import "testing"
+
+func TestExcelValue(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+	jpy := Currency{Code: "JPY", Scale: 0, Symbol: "¥"}
+	bhd := Currency{Code: "BHD", Scale: 3}
+
+	tests := []struct {
+		m      Money
+		value  float64
+		format string
+	}{
+		{New(123456, usd), 1234.56, "$#,##0.00"},
+		{New(-5, usd), -0.05, "$#,##0.00"},
+		{New(1234, jpy), 1234, `"¥"#,##0`},
+		{New(1005, bhd), 1.005, `"BHD"#,##0.000`},
+	}
+	for _, tt := range tests {
+		value, format := tt.m.ExcelValue()
+		if value != tt.value || format != tt.format {
+			t.Fatalf("excel value %d %s = %v, %q; want %v, %q", tt.m.Amount(), tt.m.Currency().Code, value, format, tt.value, tt.format)
+		}
+	}
+}
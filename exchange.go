@@ -0,0 +1,11 @@
+package money
+
+// ExchangeRate converts amounts from one currency into another.
+// One unit of From is worth Rate / 10^Scale units of To.
+// Example: ExchangeRate{From: USD, To: GBP, Rate: 79, Scale: 2} means 1 USD = 0.79 GBP.
+type ExchangeRate struct {
+	From  Currency
+	To    Currency
+	Rate  int64
+	Scale int32
+}
@@ -0,0 +1,122 @@
+package money
+
+import (
+	"math"
+	"testing"
+)
+
+func TestConvert(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+	jpy := Currency{Code: "JPY", Scale: 0, Symbol: "¥"}
+	eur := Currency{Code: "EUR", Scale: 2, Symbol: "€"}
+
+	out, err := New(1050, usd).Convert(ExchangeRate{From: usd, To: jpy, Rate: 14955, Scale: 2})
+	if err != nil {
+		t.Fatalf("convert: %v", err)
+	}
+	// 10.50 * 149.55 = 1570.275 -> 1570
+	if !out.Equal(New(1570, jpy)) {
+		t.Fatalf("convert = %v", out)
+	}
+
+	if _, err := New(1050, eur).Convert(ExchangeRate{From: usd, To: jpy, Rate: 1, Scale: 0}); err != ErrCurrencyMismatch {
+		t.Fatalf("expected ErrCurrencyMismatch, got %v", err)
+	}
+	if _, err := New(1050, usd).Convert(ExchangeRate{From: usd, To: jpy, Rate: 0, Scale: 0}); err != ErrInvalidOperation {
+		t.Fatalf("expected ErrInvalidOperation, got %v", err)
+	}
+}
+
+func TestConvertChain(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+	eur := Currency{Code: "EUR", Scale: 2, Symbol: "€"}
+	try := Currency{Code: "TRY", Scale: 2, Symbol: "₺"}
+	usdToEUR := ExchangeRate{From: usd, To: eur, Rate: 92, Scale: 2}
+	eurToTRY := ExchangeRate{From: eur, To: try, Rate: 3550, Scale: 2}
+
+	out, err := New(10000, usd).ConvertChain(usdToEUR, eurToTRY)
+	if err != nil {
+		t.Fatalf("convert chain: %v", err)
+	}
+	// 100.00 USD -> 92.00 EUR -> 3266.00 TRY
+	if !out.Equal(New(326600, try)) {
+		t.Fatalf("convert chain = %v", out)
+	}
+
+	// Rounding happens per hop: 0.01 USD -> 0.01 EUR (0.0092) -> 0.36 TRY (0.355).
+	out, err = New(1, usd).ConvertChain(usdToEUR, eurToTRY)
+	if err != nil || !out.Equal(New(36, try)) {
+		t.Fatalf("convert chain per-hop rounding = %v, %v", out, err)
+	}
+
+	if _, err := New(10000, usd).ConvertChain(eurToTRY, usdToEUR); err != ErrCurrencyMismatch {
+		t.Fatalf("expected ErrCurrencyMismatch, got %v", err)
+	}
+	if _, err := New(10000, usd).ConvertChain(usdToEUR, usdToEUR); err != ErrCurrencyMismatch {
+		t.Fatalf("expected ErrCurrencyMismatch, got %v", err)
+	}
+
+	same, err := New(10000, usd).ConvertChain()
+	if err != nil || !same.Equal(New(10000, usd)) {
+		t.Fatalf("empty chain = %v, %v", same, err)
+	}
+}
+
+func TestConvertInverse(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+	gbp := Currency{Code: "GBP", Scale: 2, Symbol: "£"}
+	jpy := Currency{Code: "JPY", Scale: 0, Symbol: "¥"}
+	eur := Currency{Code: "EUR", Scale: 2, Symbol: "€"}
+	btc := Currency{Code: "BTC", Scale: 8}
+
+	tests := []struct {
+		m    Money
+		rate ExchangeRate
+		want Money
+	}{
+		{New(7900, gbp), ExchangeRate{From: usd, To: gbp, Rate: 79, Scale: 2}, New(10000, usd)},
+		// 1570 / 149.55 = 10.4981...
+		{New(1570, jpy), ExchangeRate{From: usd, To: jpy, Rate: 14955, Scale: 2}, New(1050, usd)},
+		{New(-1570, jpy), ExchangeRate{From: usd, To: jpy, Rate: 14955, Scale: 2}, New(-1050, usd)},
+		// 0.01 / 0.08 = 0.125 -> 0.12
+		{New(1, gbp), ExchangeRate{From: usd, To: gbp, Rate: 8, Scale: 2}, New(12, usd)},
+		// 3 BTC at 2 BTC per USD: the rate scale is below the source scale.
+		{New(300000000, btc), ExchangeRate{From: usd, To: btc, Rate: 2, Scale: 0}, New(150, usd)},
+		{New(math.MaxInt64, btc), ExchangeRate{From: jpy, To: btc, Rate: math.MaxInt64, Scale: 0}, New(0, jpy)},
+	}
+	for _, tt := range tests {
+		got, err := tt.m.ConvertInverse(tt.rate)
+		if err != nil {
+			t.Fatalf("convert inverse %v: %v", tt.m, err)
+		}
+		if !got.Equal(tt.want) {
+			t.Fatalf("convert inverse %v = %v, want %v", tt.m, got, tt.want)
+		}
+	}
+
+	for _, rate := range []ExchangeRate{
+		{From: usd, To: jpy, Rate: 14955, Scale: 2},
+		{From: usd, To: eur, Rate: 9234, Scale: 4},
+	} {
+		for _, amount := range []int64{1, 99, 1050, 123456, -987654, 100000000000} {
+			there, err := New(amount, usd).Convert(rate)
+			if err != nil {
+				t.Fatalf("convert %d: %v", amount, err)
+			}
+			back, err := there.ConvertInverse(rate)
+			if err != nil {
+				t.Fatalf("convert inverse %v: %v", there, err)
+			}
+			if diff := back.Amount() - amount; diff < -1 || diff > 1 {
+				t.Fatalf("%d -> %v -> %d, off by %d", amount, there, back.Amount(), diff)
+			}
+		}
+	}
+
+	if _, err := New(7900, usd).ConvertInverse(ExchangeRate{From: usd, To: gbp, Rate: 79, Scale: 2}); err != ErrCurrencyMismatch {
+		t.Fatalf("expected ErrCurrencyMismatch, got %v", err)
+	}
+	if _, err := New(7900, gbp).ConvertInverse(ExchangeRate{From: usd, To: gbp, Rate: 0, Scale: 2}); err != ErrInvalidOperation {
+		t.Fatalf("expected ErrInvalidOperation, got %v", err)
+	}
+}
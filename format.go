@@ -1,9 +1,15 @@
 package money
 
 import (
+	"cmp"
+	"math/bits"
+	"strconv"
 	"strings"
+	"sync"
 	"sync/atomic"
 	"unicode/utf8"
+
+	"github.com/Opvra/go-money/internal/calc"
 )
 
 // SymbolPosition controls where the symbol appears relative to the amount.
@@ -14,6 +20,51 @@ type SymbolPosition int32
 // Example: SymbolUseCurrencyCode yields "USD" for Currency{Code:"USD"}.
 type SymbolKind int32
 
+// NumeralSystem selects the digits used to render amounts.
+// Example: NumeralsEasternArabic yields "١٬٢٣٤٫٥٦" with Arabic separators.
+type NumeralSystem int32
+
+const (
+	// NumeralsLatin uses the ASCII digits 0-9.
+	NumeralsLatin NumeralSystem = iota
+	// NumeralsEasternArabic uses the Arabic-Indic digits U+0660-U+0669.
+	NumeralsEasternArabic
+	// NumeralsDevanagari uses the Devanagari digits U+0966-U+096F.
+	NumeralsDevanagari
+)
+
+// SymbolResolver holds a callback that computes a currency's symbol at
+// format time. A nil resolver, or one with a nil Func, is ignored.
+// Example: &SymbolResolver{Func: func(Currency) string { return "pts" }}.
+type SymbolResolver struct {
+	Func func(Currency) string
+}
+
+// active reports whether r has a callback to call.
+func (r *SymbolResolver) active() bool {
+	return r != nil && r.Func != nil
+}
+
+// CodeCase selects the letter case of rendered currency codes.
+// Example: CodeLower yields "10.50 btc" with a code suffix.
+type CodeCase int32
+
+const (
+	// CodeAsIs renders Currency.Code unchanged; registered codes are upper case.
+	CodeAsIs CodeCase = iota
+	// CodeUpper renders the code in upper case.
+	CodeUpper
+	// CodeLower renders the code in lower case, as crypto tickers often are.
+	CodeLower
+)
+
+// numeralZeros holds the zero digit of each NumeralSystem; the others follow it.
+var numeralZeros = [...]rune{
+	NumeralsLatin:         '0',
+	NumeralsEasternArabic: '\u0660',
+	NumeralsDevanagari:    '\u0966',
+}
+
 const (
 	// SymbolPrefix places the symbol before the amount.
 	SymbolPrefix SymbolPosition = iota
@@ -28,6 +79,9 @@ const (
 	SymbolUseCurrencyCode
 	// SymbolUseCustom uses FormatConfig.CustomSymbol.
 	SymbolUseCustom
+	// SymbolUseSymbolOrCode uses Currency.Symbol, or Currency.Code when the
+	// currency has no symbol.
+	SymbolUseSymbolOrCode
 )
 
 // FormatConfig defines formatting behavior for Money rendering.
@@ -39,10 +93,102 @@ type FormatConfig struct {
 	SymbolKind         SymbolKind
 	CustomSymbol       string
 	Space              bool
+	// NegativeParens renders negative amounts as "($1.05)" instead of "-$1.05".
+	// The parentheses enclose the symbol and any space whichever side it is on,
+	// "($ 1,234.56)" or "(1,234.56 $)"; an amount that rounds to zero for
+	// display is not negative, and ZeroText takes precedence.
+	NegativeParens bool
+	// MinIntegerDigits left-pads the integer part with zeros before grouping.
+	// Example: 8 renders $10.50 as "00000010.50".
+	MinIntegerDigits int
+	// TrimTrailingZeros drops trailing zero fraction digits, and the decimal
+	// separator when none remain: "$10.50" -> "$10.5", "$10.00" -> "$10".
+	TrimTrailingZeros bool
+	// MinFractionDigits is the fewest fraction digits trimming may leave.
+	MinFractionDigits int
+	// MaxFractionDigits, when positive and below the currency scale, rounds the
+	// displayed amount half to even, carrying into the integer part: 9.999 -> "10.00".
+	// Zero means no limit.
+	MaxFractionDigits int
+	// ZeroText, when non-empty, is rendered verbatim for a zero amount, e.g. "free".
+	ZeroText string
+	// NegativePrefix and NegativeSuffix wrap the whole rendered string of a
+	// negative amount, e.g. ANSI color codes "\x1b[31m" and "\x1b[0m".
+	// Wrapped output is for display only and is not accepted by the parsers.
+	NegativePrefix string
+	NegativeSuffix string
+	// RTL wraps the digits in LEFT-TO-RIGHT MARKs (U+200E) and puts one before
+	// a leading minus sign, so numbers keep their order inside right-to-left
+	// text. The marks are invisible; RTL output is not accepted by the parsers.
+	RTL bool
+	// SuperscriptFraction renders the fraction digits as Unicode superscripts
+	// and omits the decimal separator, as on receipts: "$10⁵⁰". It has no
+	// effect when there is no fraction.
+	SuperscriptFraction bool
+	// Scientific renders amounts whose magnitude is at least ScientificThreshold
+	// major units as a mantissa and exponent, e.g. "$1.23e9"; smaller amounts and
+	// zero render normally. SignificantDigits sets the mantissa precision, rounded
+	// half to even (0 means 3). Grouping and fraction options do not apply.
+	Scientific          bool
+	ScientificThreshold int64
+	SignificantDigits   int
+	// DebitCredit replaces the minus sign with a trailing " DR" or " CR" tag:
+	// positive amounts are debits ("1,000.00 DR") and negatives credits, or the
+	// other way round with CreditPositive. Zero is untagged. It cannot be
+	// combined with NegativeParens.
+	DebitCredit    bool
+	CreditPositive bool
+	// SymbolPadWidth pads the symbol with spaces to at least this many runes
+	// so amounts line up across currencies ("$  " vs "CHF"). The padding sits
+	// between the symbol and the amount: after a prefix symbol, before a
+	// suffix one.
+	SymbolPadWidth int
+	// ShowCode adds the currency code on the outer side of the symbol, as in
+	// "USD $1,234.56" or "1.234,56 € EUR"; signs and parentheses stay inside
+	// it. It cannot be combined with SymbolUseCurrencyCode, and is skipped
+	// when SymbolUseSymbolOrCode falls back to the code.
+	ShowCode bool
+	// NumeralSystem maps the ASCII digits of the amount, including grouping
+	// and exponent digits, once it is assembled; separators, symbols and
+	// ZeroText are left as configured.
+	NumeralSystem NumeralSystem
+	// MinDigitsForGrouping leaves integer parts with fewer digits, counted
+	// after MinIntegerDigits padding, ungrouped: with 5, "1000" stays as is
+	// while "10000" becomes "10,000". 0 always groups.
+	MinDigitsForGrouping int
+	// VulgarFraction renders the fraction as minor units over a power of ten
+	// after a space, as on some receipts: "$10 50/100". It has no effect when
+	// there is no fraction and cannot be combined with SuperscriptFraction.
+	VulgarFraction bool
+	// SymbolResolver, when set, computes the symbol from the currency at
+	// format time and takes precedence over SymbolKind and CustomSymbol, e.g.
+	// "pts" for loyalty-point currencies. It is a pointer so FormatConfig stays
+	// comparable; configs are equal only when they share the same resolver.
+	// It is not encoded to JSON or the format DSL.
+	SymbolResolver *SymbolResolver `json:"-"`
+	// CodeCase sets the case of the currency code wherever it is rendered:
+	// SymbolUseCurrencyCode, the SymbolUseSymbolOrCode fallback and ShowCode.
+	// Symbols and SymbolResolver results are left alone.
+	CodeCase CodeCase
+	// MinDisplayScale pads the fraction with zeros to at least this many
+	// digits, beyond the currency scale if need be, so unified reports can
+	// show "¥123.00". It applies after trimming, affects display only, and
+	// must not exceed a positive MaxFractionDigits.
+	MinDisplayScale int
 }
 
+// lrm is the Unicode LEFT-TO-RIGHT MARK used by FormatConfig.RTL.
+const lrm = "\u200e"
+
 var formatConfig atomic.Value
 
+// formatMu serializes writers of formatConfig with formatLocked; readers only
+// Load formatConfig.
+var (
+	formatMu     sync.Mutex
+	formatLocked bool
+)
+
 func init() {
 	formatConfig.Store(FormatConfig{
 		DecimalSeparator:   ".",
@@ -54,16 +200,56 @@ func init() {
 	})
 }
 
-// SetFormat sets the global default formatting configuration.
+// SetFormat sets the global default formatting configuration. After LockFormat
+// it returns ErrFormatLocked.
 // Example: SetFormat(FormatConfig{DecimalSeparator:",", SymbolPosition:SymbolSuffix}).
 func SetFormat(cfg FormatConfig) error {
 	if err := validateFormat(cfg); err != nil {
 		return err
 	}
+	formatMu.Lock()
+	defer formatMu.Unlock()
+	if formatLocked {
+		return ErrFormatLocked
+	}
 	formatConfig.Store(cfg)
 	return nil
 }
 
+// SetFormatTemp sets the global default formatting configuration and returns a
+// function that restores the previous one, for use with defer. An invalid cfg
+// leaves the global unchanged and returns a nil restore. After LockFormat it
+// returns ErrFormatLocked, and a restore obtained earlier does nothing.
+// Example: restore, err := SetFormatTemp(EUFormat()); defer restore().
+func SetFormatTemp(cfg FormatConfig) (restore func(), err error) {
+	if err := validateFormat(cfg); err != nil {
+		return nil, err
+	}
+	formatMu.Lock()
+	defer formatMu.Unlock()
+	if formatLocked {
+		return nil, ErrFormatLocked
+	}
+	prev := formatConfig.Swap(cfg)
+	return func() {
+		formatMu.Lock()
+		defer formatMu.Unlock()
+		if !formatLocked {
+			formatConfig.Store(prev)
+		}
+	}, nil
+}
+
+// LockFormat freezes the global default format so that later SetFormat and
+// SetFormatTemp calls return ErrFormatLocked. The lock cannot be released and
+// lasts for the rest of the process; call it once configuration is done.
+// Example: SetFormat(EUFormat()); LockFormat().
+func LockFormat() {
+	formatMu.Lock()
+	defer formatMu.Unlock()
+	formatLocked = true
+}
+
 // DefaultFormat returns the current global format configuration.
 // Example: DefaultFormat().DecimalSeparator -> ".".
 func DefaultFormat() FormatConfig {
@@ -79,47 +265,519 @@ func (m Money) Format(cfg FormatConfig) (string, error) {
 	return formatWithConfig(m, cfg)
 }
 
+// FormatMagnitude renders |m| with cfg: no sign, parentheses or negative
+// wrapping, and no Abs overflow for math.MinInt64.
+// Example: New(-123456, USD).FormatMagnitude(cfg with NegativeParens) -> "$1,234.56".
+func (m Money) FormatMagnitude(cfg FormatConfig) (string, error) {
+	if err := validateFormat(cfg); err != nil {
+		return "", err
+	}
+	text, _, err := formatSigned(m, cfg, true)
+	return text, err
+}
+
+// TabString renders m with cfg as symbol and number separated by a single tab,
+// in symbol position order, for column alignment with text/tabwriter and its
+// AlignRight flag. Signs, parentheses and other wrapping stay with the number.
+// Terminate the row with a tab too, since tabwriter does not align the last
+// cell of a line. An invalid cfg yields the String diagnostic form.
+// Example: New(-1050, USD).TabString(USDFormat()) -> "$\t-10.50".
+func (m Money) TabString(cfg FormatConfig) string {
+	if err := validateFormat(cfg); err != nil {
+		return m.invalidString()
+	}
+	symbol, err := formatSymbol(m.currency, cfg)
+	if err != nil {
+		return m.invalidString()
+	}
+	bare := Money{amount: m.amount, currency: m.currency}
+	bare.currency.Symbol = ""
+	code := shownCode(m.currency, symbol, cfg)
+	cfg.SymbolKind, cfg.SymbolResolver, cfg.SymbolPadWidth, cfg.ShowCode = SymbolUseCurrencySymbol, nil, 0, false
+	number, err := formatWithConfig(bare, cfg)
+	if err != nil {
+		return m.invalidString()
+	}
+	if cfg.SymbolPosition == SymbolSuffix {
+		if code != "" {
+			symbol += " " + code
+		}
+		return number + "\t" + symbol
+	}
+	if code != "" {
+		symbol = code + " " + symbol
+	}
+	return symbol + "\t" + number
+}
+
+// Parts holds the rendered components of a formatted Money value.
+// NegativeParens and ZeroText are not applied; callers assemble the pieces.
+// Example: New(-123456, EUR) with a suffix config -> Parts{Sign:"-", Integer:"1.234", DecimalSeparator:",", Fraction:"56", Symbol:"€"}.
+type Parts struct {
+	Sign   string
+	Symbol string
+	// Code is the currency code shown beside the symbol with
+	// FormatConfig.ShowCode, or "" when it is not shown.
+	Code             string
+	Integer          string
+	DecimalSeparator string
+	Fraction         string
+	SymbolPosition   SymbolPosition
+}
+
+// FormatParts returns the formatted components of m for custom assembly.
+// Example: New(1050, USD).FormatParts(cfg) -> Parts{Symbol:"$", Integer:"10", DecimalSeparator:".", Fraction:"50"}.
+func (m Money) FormatParts(cfg FormatConfig) (Parts, error) {
+	if err := validateFormat(cfg); err != nil {
+		return Parts{}, err
+	}
+	parts, _, err := buildParts(m, cfg)
+	return parts, err
+}
+
 func formatWithConfig(m Money, cfg FormatConfig) (string, error) {
-	absDigits := absInt64String(m.amount)
-	intPart, fracPart := splitAmount(absDigits, m.currency.Scale)
-	if cfg.ThousandsSeparator != "" {
-		intPart = groupThousands(intPart, cfg.ThousandsSeparator)
+	text, _, err := formatSigned(m, cfg, false)
+	return text, err
+}
+
+// formatSigned is formatWithConfig that also returns the sign of the displayed
+// value: an amount that rounds to zero for display, or renders as ZeroText, is 0.
+// With magnitude it renders |m| as FormatMagnitude does and the sign is 0.
+// Example: formatSigned(New(-4, XAU3), {MaxFractionDigits:2}, false) -> "0.00", 0.
+func formatSigned(m Money, cfg FormatConfig, magnitude bool) (string, int, error) {
+	parts, value, err := buildParts(m, cfg)
+	if err != nil {
+		return "", 0, err
 	}
-	amount := intPart
-	if fracPart != "" {
-		amount = amount + cfg.DecimalSeparator + fracPart
+	if cfg.ZeroText != "" && value == 0 {
+		return cfg.ZeroText, 0, nil
+	}
+	body, sign := parts.Integer+parts.DecimalSeparator+parts.Fraction, cmp.Compare(value, 0)
+	if cfg.Scientific && m.amount != 0 && reachesThreshold(m, cfg.ScientificThreshold) {
+		body, sign = scientificAmount(m, cfg), cmp.Compare(m.amount, 0)
+		parts.Sign = signPrefix(m.amount)
+	}
+	if magnitude {
+		parts.Sign, sign = "", 0
+	}
+	return assemble(body, parts, sign, cfg), sign, nil
+}
+
+// reachesThreshold reports whether |m| is at least threshold major units.
+// Example: reachesThreshold(New(100000, USD), 1000) -> true.
+func reachesThreshold(m Money, threshold int64) bool {
+	unit, ok := calc.Pow10(m.currency.Scale)
+	if !ok {
+		return false
+	}
+	hi, minor := bits.Mul64(uint64(threshold), uint64(unit))
+	return hi == 0 && absUint64(m.amount) >= minor
+}
+
+// scientificAmount renders |m| as a mantissa with cfg.SignificantDigits digits
+// and a decimal exponent, rounding half to even.
+// Example: scientificAmount(New(123456789000, USD), {SignificantDigits:3}) -> "1.23e9".
+func scientificAmount(m Money, cfg FormatConfig) string {
+	n := cfg.SignificantDigits
+	if n == 0 {
+		n = 3
+	}
+	digits := absInt64String(m.amount)
+	exp := len(digits) - 1 - int(m.currency.Scale)
+	if len(digits) < n {
+		digits += strings.Repeat("0", n-len(digits))
+	}
+	head, rest := digits[:n], strings.TrimRight(digits[n:], "0")
+	mantissa, _ := strconv.ParseUint(head, 10, 64)
+	if rest != "" {
+		half := "5"
+		if rest > half || (rest == half && mantissa%2 != 0) {
+			mantissa++
+		}
+	}
+	text := strconv.FormatUint(mantissa, 10)
+	if len(text) > n {
+		// Rounding carried into a new digit: 9.995e9 -> 1.00e10.
+		text, exp = text[:n], exp+1
+	}
+	if n > 1 {
+		text = text[:1] + cfg.DecimalSeparator + text[1:]
+	}
+	return text + "e" + strconv.Itoa(exp)
+}
+
+// assemble places the symbol, the sign or parentheses, the ShowCode code and
+// the debit/credit tag around a rendered amount, in that order; sign is the sign of the displayed value, and 0
+// renders no indicator at all.
+// Example: assemble("10.50", Parts{Sign:"-", Symbol:"$"}, -1, {NegativeParens:true}) -> "($10.50)".
+func assemble(amount string, parts Parts, sign int, cfg FormatConfig) string {
+	if cfg.NumeralSystem != NumeralsLatin {
+		amount = mapNumerals(amount, cfg.NumeralSystem)
+	}
+	if sign == 0 || cfg.DebitCredit {
+		parts.Sign = ""
+	}
+	if cfg.RTL {
+		amount = lrm + amount + lrm
+		if parts.Sign != "" {
+			parts.Sign = lrm + parts.Sign
+		}
+	}
+	if pad := cfg.SymbolPadWidth - utf8.RuneCountInString(parts.Symbol); pad > 0 {
+		if parts.SymbolPosition == SymbolSuffix {
+			parts.Symbol = strings.Repeat(" ", pad) + parts.Symbol
+		} else {
+			parts.Symbol += strings.Repeat(" ", pad)
+		}
+	}
+	sep := ""
+	if cfg.Space && parts.Symbol != "" {
+		sep = " "
+	}
+	body := parts.Symbol + sep + amount
+	if parts.SymbolPosition == SymbolSuffix {
+		body = amount + sep + parts.Symbol
+	}
+	if sign < 0 {
+		if cfg.NegativeParens {
+			body = "(" + body + ")"
+		} else {
+			body = parts.Sign + body
+		}
+	}
+	if parts.Code != "" {
+		if parts.SymbolPosition == SymbolSuffix {
+			body += " " + parts.Code
+		} else {
+			body = parts.Code + " " + body
+		}
+	}
+	if cfg.DebitCredit && sign != 0 {
+		if (sign > 0) == cfg.CreditPositive {
+			body += " CR"
+		} else {
+			body += " DR"
+		}
+	}
+	if sign >= 0 {
+		return body
+	}
+	return cfg.NegativePrefix + body + cfg.NegativeSuffix
+}
+
+// compactUnits are the FormatCompact magnitudes, largest first.
+var compactUnits = []struct {
+	exp    int32
+	suffix string
+}{
+	{12, "T"},
+	{9, "B"},
+	{6, "M"},
+	{3, "K"},
+}
+
+// FormatCompact renders large amounts abbreviated to one fraction digit with a
+// K, M, B or T suffix, rounding half to even and dropping a zero tenth.
+// Amounts below one thousand major units render as Format would. The symbol,
+// separators and NegativeParens follow cfg; grouping and digit options apply
+// only to unabbreviated amounts.
+// Example: New(-123456789, USD).FormatCompact(cfg with NegativeParens) -> "($1.2M)".
+func (m Money) FormatCompact(cfg FormatConfig) (string, error) {
+	if err := validateFormat(cfg); err != nil {
+		return "", err
+	}
+	for i, unit := range compactUnits {
+		divisor, ok := calc.Pow10(m.currency.Scale + unit.exp - 1)
+		if m.currency.Scale < 0 || !ok || absUint64(m.amount) < uint64(divisor)*10 {
+			continue
+		}
+		tenths, err := calc.DivMode(m.amount, divisor, calc.HalfEven)
+		if err != nil {
+			return "", ErrInvalidOperation
+		}
+		if tenths < 0 {
+			tenths = -tenths
+		}
+		suffix := unit.suffix
+		if tenths >= 10000 && i > 0 {
+			// Rounding carried into the next unit: 999.96K -> 1M.
+			tenths, suffix = tenths/1000, compactUnits[i-1].suffix
+		}
+		symbol, err := formatSymbol(m.currency, cfg)
+		if err != nil {
+			return "", err
+		}
+		amount := strconv.FormatInt(tenths/10, 10)
+		if frac := tenths % 10; frac != 0 {
+			amount += cfg.DecimalSeparator + strconv.FormatInt(frac, 10)
+		}
+		parts := Parts{Sign: signPrefix(m.amount), Symbol: symbol, Code: shownCode(m.currency, symbol, cfg), SymbolPosition: cfg.SymbolPosition}
+		return assemble(amount+suffix, parts, cmp.Compare(m.amount, 0), cfg), nil
+	}
+	return formatWithConfig(m, cfg)
+}
+
+// ApproxString renders m for quick logging: FormatCompact with a code suffix,
+// at most two fraction digits and trailing zeros trimmed. It is lossy by
+// design; never parse it back or use it where exact amounts matter. Values that
+// cannot be formatted yield the String diagnostic form.
+// Example: New(123456789, USD).ApproxString() -> "1.2M USD".
+func (m Money) ApproxString() string {
+	text, err := m.FormatCompact(CodeSuffixFormat().With(WithMaxFractionDigits(2), WithTrimTrailingZeros(true)))
+	if err != nil {
+		return m.invalidString()
+	}
+	return text
+}
+
+// buildParts computes the display components and the displayed minor-unit value.
+// Example: buildParts(New(9999, XAU3), {MaxFractionDigits:2}) -> Integer "10", Fraction "00", value 1000.
+func buildParts(m Money, cfg FormatConfig) (Parts, int64, error) {
+	value, scale := m.amount, m.currency.Scale
+	if cfg.MaxFractionDigits > 0 && cfg.MaxFractionDigits < int(scale) {
+		rounded, err := calc.Rescale(value, scale, int32(cfg.MaxFractionDigits))
+		if err != nil {
+			return Parts{}, 0, ErrInvalidOperation
+		}
+		value, scale = rounded, int32(cfg.MaxFractionDigits)
+	}
+
+	intPart, fracPart := splitAmount(absInt64String(value), scale)
+	if pad := cfg.MinIntegerDigits - len(intPart); pad > 0 {
+		intPart = strings.Repeat("0", pad) + intPart
+	}
+	if cfg.TrimTrailingZeros {
+		fracPart = trimFraction(fracPart, cfg.MinFractionDigits)
+	}
+	if pad := cfg.MinDisplayScale - len(fracPart); pad > 0 {
+		fracPart += strings.Repeat("0", pad)
+	}
+	if cfg.ThousandsSeparator != "" && len(intPart) >= cfg.MinDigitsForGrouping {
+		intPart = groupThousands(intPart, cfg.ThousandsSeparator)
 	}
 
 	symbol, err := formatSymbol(m.currency, cfg)
+	if err != nil {
+		return Parts{}, 0, err
+	}
+
+	parts := Parts{
+		Sign:           signPrefix(value),
+		Symbol:         symbol,
+		Code:           shownCode(m.currency, symbol, cfg),
+		Integer:        intPart,
+		Fraction:       fracPart,
+		SymbolPosition: cfg.SymbolPosition,
+	}
+	switch {
+	case fracPart == "":
+	case cfg.SuperscriptFraction:
+		parts.Fraction = superscriptDigits(fracPart)
+	case cfg.VulgarFraction:
+		parts.DecimalSeparator = " "
+		parts.Fraction = fracPart + "/1" + strings.Repeat("0", len(fracPart))
+	default:
+		parts.DecimalSeparator = cfg.DecimalSeparator
+	}
+	return parts, value, nil
+}
+
+// mapNumerals replaces the ASCII digits in s with those of system.
+// Example: mapNumerals("1,234.56", NumeralsDevanagari) -> "१,२३४.५६".
+func mapNumerals(s string, system NumeralSystem) string {
+	zero := numeralZeros[system]
+	return strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return zero + r - '0'
+		}
+		return r
+	}, s)
+}
+
+var superscripts = [10]string{"⁰", "¹", "²", "³", "⁴", "⁵", "⁶", "⁷", "⁸", "⁹"}
+
+// superscriptDigits maps ASCII digits to their superscript forms.
+// Example: superscriptDigits("50") -> "⁵⁰".
+func superscriptDigits(digits string) string {
+	var b strings.Builder
+	for i := 0; i < len(digits); i++ {
+		b.WriteString(superscripts[digits[i]-'0'])
+	}
+	return b.String()
+}
+
+// FormatColumn renders same-currency amounts right-aligned to a common width.
+// With NegativeParens, non-negative rows get a trailing space so digits line up
+// with the closing parenthesis of negative rows.
+// Example: FormatColumn([]Money{New(5, USD), New(-123456, USD)}, cfg) -> ["     $0.05 ", "($1,234.56)"].
+func FormatColumn(items []Money, cfg FormatConfig) ([]string, error) {
+	if err := validateFormat(cfg); err != nil {
+		return nil, err
+	}
+	out := make([]string, len(items))
+	// Decide from the displayed sign: a negative amount that rounds to zero
+	// renders without parentheses or negative wrapping.
+	negative := make([]bool, len(items))
+	hasParens := false
+	for i, item := range items {
+		if !sameCurrency(item.currency, items[0].currency) {
+			return nil, ErrCurrencyMismatch
+		}
+		text, sign, err := formatSigned(item, cfg, false)
+		if err != nil {
+			return nil, err
+		}
+		out[i], negative[i] = text, sign < 0
+		if cfg.NegativeParens && negative[i] {
+			hasParens = true
+		}
+	}
+	// NegativePrefix and NegativeSuffix are typically invisible escape codes
+	// and RTL marks have no width, so neither counts toward the column width.
+	wrap := utf8.RuneCountInString(cfg.NegativePrefix + cfg.NegativeSuffix)
+	widths := make([]int, len(items))
+	width := 0
+	for i := range items {
+		if hasParens && !negative[i] {
+			out[i] += " "
+		}
+		widths[i] = utf8.RuneCountInString(out[i]) - strings.Count(out[i], lrm)
+		if negative[i] {
+			widths[i] -= wrap
+		}
+		width = max(width, widths[i])
+	}
+	for i := range out {
+		out[i] = strings.Repeat(" ", width-widths[i]) + out[i]
+	}
+	return out, nil
+}
+
+// FormatTable renders same-currency amounts with a common number of fraction
+// digits: the most any item needs under cfg, so TrimTrailingZeros only drops
+// digits that every row can drop. Without trimming it matches formatting each
+// item on its own. Rows are not padded; see FormatColumn for alignment.
+// Example: FormatTable([]Money{New(1050, USD), New(1055, USD)}, cfg with TrimTrailingZeros) -> ["$10.50", "$10.55"].
+func FormatTable(items []Money, cfg FormatConfig) ([]string, error) {
+	if err := validateFormat(cfg); err != nil {
+		return nil, err
+	}
+	// Count plain digits; superscript and vulgar fractions change the text.
+	plain := cfg
+	plain.SuperscriptFraction, plain.VulgarFraction = false, false
+	digits := cfg.MinFractionDigits
+	for _, item := range items {
+		if !sameCurrency(item.currency, items[0].currency) {
+			return nil, ErrCurrencyMismatch
+		}
+		parts, _, err := buildParts(item, plain)
+		if err != nil {
+			return nil, err
+		}
+		digits = max(digits, len(parts.Fraction))
+	}
+	cfg.MinFractionDigits = digits
+	out := make([]string, len(items))
+	for i, item := range items {
+		text, err := formatWithConfig(item, cfg)
+		if err != nil {
+			return nil, err
+		}
+		out[i] = text
+	}
+	return out, nil
+}
+
+// FormatRelativeTo renders the difference between m and base as an unsigned
+// amount followed by "over" or "under", or "on budget" when they are equal.
+// Both must share a currency.
+// Example: New(35000, USD).FormatRelativeTo(New(10000, USD), cfg) -> "$250.00 over".
+func (m Money) FormatRelativeTo(base Money, cfg FormatConfig) (string, error) {
+	diff, err := m.Sub(base)
+	if err != nil {
+		return "", err
+	}
+	if diff.IsZero() {
+		if err := validateFormat(cfg); err != nil {
+			return "", err
+		}
+		return "on budget", nil
+	}
+	word := " over"
+	if diff.IsNegative() {
+		word = " under"
+	}
+	abs, err := diff.Abs()
+	if err != nil {
+		return "", err
+	}
+	text, err := abs.Format(cfg)
 	if err != nil {
 		return "", err
 	}
+	return text + word, nil
+}
 
-	sep := ""
-	if cfg.Space {
-		sep = " "
+// CompactString renders the currency code immediately followed by the plain amount.
+// The result is parseable with ParseCompact.
+// Example: New(-1050, USD).CompactString() -> "USD-10.50".
+func (m Money) CompactString() string {
+	return m.currency.Code + plainAmount(m)
+}
+
+// plainAmount renders the signed amount with "." and no grouping or symbol.
+// Example: plainAmount(New(-1050, USD)) -> "-10.50".
+func plainAmount(m Money) string {
+	intPart, fracPart := splitAmount(absInt64String(m.amount), m.currency.Scale)
+	if fracPart != "" {
+		intPart += "." + fracPart
 	}
-	if symbol == "" {
-		sep = ""
+	return signPrefix(m.amount) + intPart
+}
+
+// shownCode returns the code ShowCode adds next to symbol, or "" when it is off
+// or would repeat the symbol.
+// Example: shownCode(USD, "$", {ShowCode:true}) -> "USD".
+func shownCode(currency Currency, symbol string, cfg FormatConfig) string {
+	code := casedCode(currency, cfg)
+	if !cfg.ShowCode || symbol == code {
+		return ""
 	}
+	return code
+}
 
-	if cfg.SymbolPosition == SymbolSuffix {
-		return signPrefix(m.amount) + amount + sep + symbol, nil
+// casedCode returns currency.Code in the case cfg.CodeCase selects.
+// Example: casedCode(USD, {CodeCase:CodeLower}) -> "usd".
+func casedCode(currency Currency, cfg FormatConfig) string {
+	switch cfg.CodeCase {
+	case CodeUpper:
+		return strings.ToUpper(currency.Code)
+	case CodeLower:
+		return strings.ToLower(currency.Code)
+	default:
+		return currency.Code
 	}
-	return signPrefix(m.amount) + symbol + sep + amount, nil
 }
 
 func formatSymbol(currency Currency, cfg FormatConfig) (string, error) {
+	if cfg.SymbolResolver.active() {
+		return cfg.SymbolResolver.Func(currency), nil
+	}
 	switch cfg.SymbolKind {
 	case SymbolUseCurrencySymbol:
 		return currency.Symbol, nil
 	case SymbolUseCurrencyCode:
-		return currency.Code, nil
+		return casedCode(currency, cfg), nil
 	case SymbolUseCustom:
 		if cfg.CustomSymbol == "" {
 			return "", ErrInvalidOperation
 		}
 		return cfg.CustomSymbol, nil
+	case SymbolUseSymbolOrCode:
+		if currency.Symbol == "" {
+			return casedCode(currency, cfg), nil
+		}
+		return currency.Symbol, nil
 	default:
 		return "", ErrInvalidOperation
 	}
@@ -138,7 +796,36 @@ func validateFormat(cfg FormatConfig) error {
 	if cfg.ThousandsSeparator != "" && cfg.ThousandsSeparator == cfg.DecimalSeparator {
 		return ErrInvalidOperation
 	}
-	if cfg.SymbolKind == SymbolUseCustom && cfg.CustomSymbol == "" {
+	if !cfg.SymbolResolver.active() && cfg.SymbolKind == SymbolUseCustom && cfg.CustomSymbol == "" {
+		return ErrInvalidOperation
+	}
+	if cfg.MinIntegerDigits < 0 || cfg.MinFractionDigits < 0 || cfg.MaxFractionDigits < 0 {
+		return ErrInvalidOperation
+	}
+	if cfg.DebitCredit && cfg.NegativeParens {
+		return ErrInvalidOperation
+	}
+	if !cfg.SymbolResolver.active() && cfg.ShowCode && cfg.SymbolKind == SymbolUseCurrencyCode {
+		return ErrInvalidOperation
+	}
+	if cfg.VulgarFraction && cfg.SuperscriptFraction {
+		return ErrInvalidOperation
+	}
+	if cfg.NumeralSystem < 0 || int(cfg.NumeralSystem) >= len(numeralZeros) {
+		return ErrInvalidOperation
+	}
+	switch cfg.CodeCase {
+	case CodeAsIs, CodeUpper, CodeLower:
+	default:
+		return ErrInvalidOperation
+	}
+	if cfg.ScientificThreshold < 0 || cfg.SymbolPadWidth < 0 || cfg.MinDigitsForGrouping < 0 || cfg.MinDisplayScale < 0 || cfg.SignificantDigits < 0 || cfg.SignificantDigits > 19 {
+		return ErrInvalidOperation
+	}
+	if cfg.MaxFractionDigits > 0 && max(cfg.MinFractionDigits, cfg.MinDisplayScale) > cfg.MaxFractionDigits {
+		return ErrInvalidOperation
+	}
+	if !utf8.ValidString(cfg.ZeroText) || !utf8.ValidString(cfg.NegativePrefix) || !utf8.ValidString(cfg.NegativeSuffix) {
 		return ErrInvalidOperation
 	}
 	switch cfg.SymbolPosition {
@@ -147,7 +834,7 @@ func validateFormat(cfg FormatConfig) error {
 		return ErrInvalidOperation
 	}
 	switch cfg.SymbolKind {
-	case SymbolUseCurrencySymbol, SymbolUseCurrencyCode, SymbolUseCustom:
+	case SymbolUseCurrencySymbol, SymbolUseCurrencyCode, SymbolUseCustom, SymbolUseSymbolOrCode:
 	default:
 		return ErrInvalidOperation
 	}
@@ -167,6 +854,16 @@ func splitAmount(absDigits string, scale int32) (string, string) {
 	return intPart, fracPart
 }
 
+// trimFraction drops trailing zeros while keeping at least keep digits.
+// Example: trimFraction("500", 1) -> "5"; trimFraction("000", 2) -> "00".
+func trimFraction(fracPart string, keep int) string {
+	end := len(fracPart)
+	for end > keep && fracPart[end-1] == '0' {
+		end--
+	}
+	return fracPart[:end]
+}
+
 func groupThousands(intPart, sep string) string {
 	if len(intPart) <= 3 {
 		return intPart
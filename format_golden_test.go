@@ -0,0 +1,119 @@
+package money
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "rewrite testdata golden files")
+
+// TestFormatGolden pins the rendering of a matrix of amounts and currencies,
+// and the JSON encoding of each preset, so changes to defaults show up as a
+// diff of testdata/format.golden. Run with -update after an intended change.
+func TestFormatGolden(t *testing.T) {
+	currencies := []Currency{
+		{Code: "USD", Scale: 2, Symbol: "$"},
+		{Code: "EUR", Scale: 2, Symbol: "€"},
+		{Code: "JPY", Scale: 0, Symbol: "¥"},
+		{Code: "BHD", Scale: 3, Symbol: "BD"},
+		{Code: "XTS", Scale: 2},
+	}
+	amounts := []int64{0, 1, -1, 5, 105, -123456, 100000000, 123456789012, math.MaxInt64, math.MinInt64}
+
+	type preset struct {
+		name string
+		cfg  FormatConfig
+	}
+	presets := []preset{
+		{"default", defaultGoldenFormat(t)},
+		{"usd", USDFormat()},
+		{"eu", EUFormat()},
+		{"codesuffix", CodeSuffixFormat()},
+	}
+	locales := make([]string, 0, len(localeFormats))
+	for locale := range localeFormats {
+		locales = append(locales, locale)
+	}
+	slices.Sort(locales)
+	for _, locale := range locales {
+		cfg, err := LocaleFormat(locale)
+		if err != nil {
+			t.Fatalf("locale %s: %v", locale, err)
+		}
+		presets = append(presets, preset{"locale " + locale, cfg})
+	}
+	presets = append(presets,
+		preset{"usd parens", USDFormat().With(WithNegativeParens(true))},
+		preset{"usd trim", USDFormat().With(WithTrimTrailingZeros(true))},
+		preset{"codesuffix symbolorcode", CodeSuffixFormat().With(WithSymbolKind(SymbolUseSymbolOrCode))},
+	)
+
+	var b bytes.Buffer
+	b.WriteString("# Generated by TestFormatGolden; regenerate with go test -run TestFormatGolden -update.\n")
+	for _, p := range presets {
+		enc, err := json.Marshal(p.cfg)
+		if err != nil {
+			t.Fatalf("%s: marshal: %v", p.name, err)
+		}
+		fmt.Fprintf(&b, "\n[%s]\n%s\n", p.name, enc)
+		for _, c := range currencies {
+			for _, a := range amounts {
+				text, err := New(a, c).Format(p.cfg)
+				if err != nil {
+					text = "error: " + err.Error()
+				}
+				fmt.Fprintf(&b, "%s %d\t%q\n", c.Code, a, text)
+			}
+		}
+	}
+
+	path := filepath.Join("testdata", "format.golden")
+	if *update {
+		if err := os.MkdirAll("testdata", 0o755); err != nil {
+			t.Fatalf("mkdir: %v", err)
+		}
+		if err := os.WriteFile(path, b.Bytes(), 0o644); err != nil {
+			t.Fatalf("write golden: %v", err)
+		}
+		return
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read golden (run with -update to create it): %v", err)
+	}
+	if !bytes.Equal(b.Bytes(), want) {
+		got := bytes.Split(b.Bytes(), []byte("\n"))
+		lines := bytes.Split(want, []byte("\n"))
+		for i := range max(len(got), len(lines)) {
+			var g, w []byte
+			if i < len(got) {
+				g = got[i]
+			}
+			if i < len(lines) {
+				w = lines[i]
+			}
+			if !bytes.Equal(g, w) {
+				t.Fatalf("%s:%d differs (run with -update if intended):\ngot:  %s\nwant: %s", path, i+1, g, w)
+			}
+		}
+	}
+}
+
+// defaultGoldenFormat returns the built-in global format, failing if another
+// test left SetFormat changed.
+func defaultGoldenFormat(t *testing.T) FormatConfig {
+	t.Helper()
+	cfg := DefaultFormat()
+	builtin := FormatConfig{DecimalSeparator: ".", SymbolPosition: SymbolPrefix, SymbolKind: SymbolUseCurrencySymbol}
+	if cfg != builtin {
+		t.Fatalf("global format is %+v, want the built-in default", cfg)
+	}
+	return cfg
+}
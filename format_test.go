@@ -0,0 +1,1248 @@
+package money
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+	"text/tabwriter"
+)
+
+func TestFormatColumn(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+	cfg := FormatConfig{
+		DecimalSeparator:   ".",
+		ThousandsSeparator: ",",
+		SymbolPosition:     SymbolPrefix,
+		SymbolKind:         SymbolUseCurrencySymbol,
+		NegativeParens:     true,
+	}
+
+	got, err := FormatColumn([]Money{
+		New(5, usd),
+		New(-123456, usd),
+		New(100000000, usd),
+		New(-1050, usd),
+	}, cfg)
+	if err != nil {
+		t.Fatalf("format column: %v", err)
+	}
+	want := []string{
+		"        $0.05 ",
+		"   ($1,234.56)",
+		"$1,000,000.00 ",
+		"      ($10.50)",
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("row %d = %q, want %q", i, got[i], want[i])
+		}
+	}
+}
+
+func TestFormatColumnSuffix(t *testing.T) {
+	eur := Currency{Code: "EUR", Scale: 2, Symbol: "€"}
+	cfg := FormatConfig{
+		DecimalSeparator:   ",",
+		ThousandsSeparator: ".",
+		SymbolPosition:     SymbolSuffix,
+		SymbolKind:         SymbolUseCurrencySymbol,
+		Space:              true,
+	}
+
+	got, err := FormatColumn([]Money{New(-5, eur), New(123456, eur)}, cfg)
+	if err != nil {
+		t.Fatalf("format column: %v", err)
+	}
+	if got[0] != "   -0,05 €" || got[1] != "1.234,56 €" {
+		t.Fatalf("column = %q", got)
+	}
+}
+
+func TestFormatColumnRoundsToZero(t *testing.T) {
+	xau := Currency{Code: "XAU", Scale: 3, Symbol: "oz"}
+	cfg := USDFormat().With(WithNegativeParens(true), WithMaxFractionDigits(2))
+	wrapped := USDFormat().With(WithMaxFractionDigits(2), WithNegativePrefix("<"), WithNegativeSuffix(">"))
+
+	tests := []struct {
+		items []Money
+		cfg   FormatConfig
+		want  []string
+	}{
+		{[]Money{New(-4, xau), New(1000, xau)}, cfg, []string{"oz0.00", "oz1.00"}},
+		{[]Money{New(-4, xau), New(1000, xau), New(-1000, xau)}, cfg, []string{" oz0.00 ", " oz1.00 ", "(oz1.00)"}},
+		{[]Money{New(-4, xau), New(-1000, xau)}, wrapped, []string{" oz0.00", "<-oz1.00>"}},
+	}
+	for _, tt := range tests {
+		got, err := FormatColumn(tt.items, tt.cfg)
+		if err != nil {
+			t.Fatalf("format column: %v", err)
+		}
+		for i := range tt.want {
+			if got[i] != tt.want[i] {
+				t.Fatalf("column = %q, want %q", got, tt.want)
+			}
+		}
+	}
+}
+
+func TestFormatColumnMismatch(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+	eur := Currency{Code: "EUR", Scale: 2, Symbol: "€"}
+	_, err := FormatColumn([]Money{New(1, usd), New(1, eur)}, DefaultFormat())
+	if err != ErrCurrencyMismatch {
+		t.Fatalf("expected ErrCurrencyMismatch, got %v", err)
+	}
+}
+
+func TestFormatMinIntegerDigits(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: ""}
+	cfg := FormatConfig{
+		DecimalSeparator: ".",
+		SymbolKind:       SymbolUseCurrencySymbol,
+		MinIntegerDigits: 10,
+	}
+
+	text, err := New(1050, usd).Format(cfg)
+	if err != nil {
+		t.Fatalf("format: %v", err)
+	}
+	if text != "0000000010.50" {
+		t.Fatalf("format = %s", text)
+	}
+
+	cfg.ThousandsSeparator = ","
+	text, err = New(-1050, usd).Format(cfg)
+	if err != nil {
+		t.Fatalf("format: %v", err)
+	}
+	if text != "-0,000,000,010.50" {
+		t.Fatalf("format = %s", text)
+	}
+
+	cfg.MinIntegerDigits = 2
+	text, err = New(123456, usd).Format(cfg)
+	if err != nil {
+		t.Fatalf("format: %v", err)
+	}
+	if text != "1,234.56" {
+		t.Fatalf("format = %s", text)
+	}
+
+	cfg.MinIntegerDigits = -1
+	if _, err := New(1050, usd).Format(cfg); err != ErrInvalidOperation {
+		t.Fatalf("expected ErrInvalidOperation, got %v", err)
+	}
+}
+
+func TestFormatTrimTrailingZeros(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+	bhd := Currency{Code: "BHD", Scale: 3, Symbol: "BD"}
+	cfg := FormatConfig{
+		DecimalSeparator:   ".",
+		ThousandsSeparator: ",",
+		SymbolKind:         SymbolUseCurrencySymbol,
+		TrimTrailingZeros:  true,
+	}
+
+	tests := []struct {
+		m       Money
+		minFrac int
+		want    string
+	}{
+		{New(1050, usd), 0, "$10.5"},
+		{New(1000, usd), 0, "$10"},
+		{New(1055, usd), 0, "$10.55"},
+		{New(-123400, usd), 0, "-$1,234"},
+		{New(1000, usd), 2, "$10.00"},
+		{New(1050, usd), 2, "$10.50"},
+		{New(1000, usd), 1, "$10.0"},
+		{New(10100, bhd), 1, "BD10.1"},
+		{New(10000, bhd), 5, "BD10.000"},
+	}
+	for _, tt := range tests {
+		cfg.MinFractionDigits = tt.minFrac
+		got, err := tt.m.Format(cfg)
+		if err != nil {
+			t.Fatalf("format: %v", err)
+		}
+		if got != tt.want {
+			t.Fatalf("format %d (min %d) = %q, want %q", tt.m.Amount(), tt.minFrac, got, tt.want)
+		}
+	}
+
+	cfg.MinFractionDigits = -1
+	if _, err := New(1, usd).Format(cfg); err != ErrInvalidOperation {
+		t.Fatalf("expected ErrInvalidOperation, got %v", err)
+	}
+}
+
+func TestFormatZeroText(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+	cfg := FormatConfig{
+		DecimalSeparator: ".",
+		SymbolKind:       SymbolUseCurrencySymbol,
+		NegativeParens:   true,
+		ZeroText:         "free",
+	}
+
+	tests := []struct {
+		m    Money
+		want string
+	}{
+		{Zero(usd), "free"},
+		{New(1, usd), "$0.01"},
+		{New(-1, usd), "($0.01)"},
+	}
+	for _, tt := range tests {
+		got, err := tt.m.Format(cfg)
+		if err != nil {
+			t.Fatalf("format: %v", err)
+		}
+		if got != tt.want {
+			t.Fatalf("format %d = %q, want %q", tt.m.Amount(), got, tt.want)
+		}
+	}
+
+	cfg.ZeroText = "\xff"
+	if _, err := Zero(usd).Format(cfg); err != ErrInvalidOperation {
+		t.Fatalf("expected ErrInvalidOperation, got %v", err)
+	}
+}
+
+func TestFormatMaxFractionDigitsCarry(t *testing.T) {
+	xau := Currency{Code: "XAU", Scale: 3, Symbol: "$"}
+	cfg := FormatConfig{
+		DecimalSeparator:   ".",
+		ThousandsSeparator: ",",
+		SymbolKind:         SymbolUseCurrencySymbol,
+		MaxFractionDigits:  2,
+	}
+
+	tests := []struct {
+		amount int64
+		want   string
+	}{
+		{9999, "$10.00"},
+		{999999, "$1,000.00"},
+		{-999999, "-$1,000.00"},
+		{999999999, "$1,000,000.00"},
+		{12344, "$12.34"},
+		{12345, "$12.34"},
+		{12355, "$12.36"},
+		{-4, "$0.00"},
+	}
+	for _, tt := range tests {
+		got, err := New(tt.amount, xau).Format(cfg)
+		if err != nil {
+			t.Fatalf("format: %v", err)
+		}
+		if got != tt.want {
+			t.Fatalf("format %d = %q, want %q", tt.amount, got, tt.want)
+		}
+	}
+
+	cfg.MaxFractionDigits = 1
+	cfg.MinIntegerDigits = 4
+	got, err := New(999951, xau).Format(cfg)
+	if err != nil {
+		t.Fatalf("format: %v", err)
+	}
+	if got != "$1,000.0" {
+		t.Fatalf("format = %q", got)
+	}
+
+	cfg.MaxFractionDigits = 5
+	got, err = New(12345, xau).Format(cfg)
+	if err != nil {
+		t.Fatalf("format: %v", err)
+	}
+	if got != "$0,012.345" {
+		t.Fatalf("format = %q", got)
+	}
+
+	cfg.MaxFractionDigits = 1
+	cfg.MinFractionDigits = 2
+	if _, err := New(1, xau).Format(cfg); err != ErrInvalidOperation {
+		t.Fatalf("expected ErrInvalidOperation, got %v", err)
+	}
+}
+
+func TestFormatParts(t *testing.T) {
+	eur := Currency{Code: "EUR", Scale: 2, Symbol: "€"}
+	cfg := FormatConfig{
+		DecimalSeparator:   ",",
+		ThousandsSeparator: ".",
+		SymbolPosition:     SymbolSuffix,
+		SymbolKind:         SymbolUseCurrencySymbol,
+		Space:              true,
+	}
+
+	got, err := New(-123456789, eur).FormatParts(cfg)
+	if err != nil {
+		t.Fatalf("format parts: %v", err)
+	}
+	want := Parts{
+		Sign:             "-",
+		Symbol:           "€",
+		Integer:          "1.234.567",
+		DecimalSeparator: ",",
+		Fraction:         "89",
+		SymbolPosition:   SymbolSuffix,
+	}
+	if got != want {
+		t.Fatalf("parts = %+v, want %+v", got, want)
+	}
+
+	jpy := Currency{Code: "JPY", Scale: 0, Symbol: "¥"}
+	got, err = New(123, jpy).FormatParts(DefaultFormat())
+	if err != nil {
+		t.Fatalf("format parts: %v", err)
+	}
+	if got != (Parts{Symbol: "¥", Integer: "123"}) {
+		t.Fatalf("parts = %+v", got)
+	}
+
+	if _, err := New(1, eur).FormatParts(FormatConfig{}); err != ErrInvalidOperation {
+		t.Fatalf("expected ErrInvalidOperation, got %v", err)
+	}
+}
+
+func TestFormatRelativeTo(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+	eur := Currency{Code: "EUR", Scale: 2, Symbol: "€"}
+	cfg := FormatConfig{DecimalSeparator: ".", ThousandsSeparator: ",", SymbolKind: SymbolUseCurrencySymbol, NegativeParens: true}
+	budget := New(100000, usd)
+
+	tests := []struct {
+		spent Money
+		want  string
+	}{
+		{New(125000, usd), "$250.00 over"},
+		{New(90000, usd), "$100.00 under"},
+		{New(-50000, usd), "$1,500.00 under"},
+		{New(100000, usd), "on budget"},
+	}
+	for _, tt := range tests {
+		got, err := tt.spent.FormatRelativeTo(budget, cfg)
+		if err != nil {
+			t.Fatalf("format relative: %v", err)
+		}
+		if got != tt.want {
+			t.Fatalf("format relative %d = %q, want %q", tt.spent.Amount(), got, tt.want)
+		}
+	}
+
+	if _, err := New(1, eur).FormatRelativeTo(budget, cfg); err != ErrCurrencyMismatch {
+		t.Fatalf("expected ErrCurrencyMismatch, got %v", err)
+	}
+	if _, err := budget.FormatRelativeTo(budget, FormatConfig{}); err != ErrInvalidOperation {
+		t.Fatalf("expected ErrInvalidOperation, got %v", err)
+	}
+}
+
+func TestFormatCompact(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+	eur := Currency{Code: "EUR", Scale: 2, Symbol: "€"}
+	jpy := Currency{Code: "JPY", Scale: 0, Symbol: "¥"}
+	parens := FormatConfig{DecimalSeparator: ".", ThousandsSeparator: ",", SymbolKind: SymbolUseCurrencySymbol, NegativeParens: true}
+	euro := FormatConfig{DecimalSeparator: ",", ThousandsSeparator: ".", SymbolPosition: SymbolSuffix, SymbolKind: SymbolUseCurrencySymbol, Space: true, NegativeParens: true}
+	plain := FormatConfig{DecimalSeparator: ".", SymbolKind: SymbolUseCurrencySymbol}
+
+	tests := []struct {
+		m    Money
+		cfg  FormatConfig
+		want string
+	}{
+		{New(-123456789, usd), parens, "($1.2M)"},
+		{New(123456789, usd), parens, "$1.2M"},
+		{New(-123456789, usd), plain, "-$1.2M"},
+		{New(-250000000, eur), euro, "(2,5M €)"},
+		{New(100000000, usd), parens, "$1M"},
+		{New(99996, usd), parens, "$999.96"},
+		{New(100000, usd), parens, "$1K"},
+		{New(94000000, usd), parens, "$940K"},
+		{New(95000000, usd), parens, "$950K"},
+		{New(99999600, usd), parens, "$1M"},
+		{New(4200000000000, jpy), parens, "¥4.2T"},
+		{New(-5000, usd), parens, "($50.00)"},
+		{New(99949, usd), parens, "$999.49"},
+	}
+	for _, tt := range tests {
+		got, err := tt.m.FormatCompact(tt.cfg)
+		if err != nil {
+			t.Fatalf("format compact: %v", err)
+		}
+		if got != tt.want {
+			t.Fatalf("format compact %d = %q, want %q", tt.m.Amount(), got, tt.want)
+		}
+	}
+
+	if _, err := New(1, usd).FormatCompact(FormatConfig{}); err != ErrInvalidOperation {
+		t.Fatalf("expected ErrInvalidOperation, got %v", err)
+	}
+}
+
+func TestFormatNegativeWrap(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+	cfg := FormatConfig{
+		DecimalSeparator: ".",
+		SymbolKind:       SymbolUseCurrencySymbol,
+		NegativePrefix:   "<red>",
+		NegativeSuffix:   "</red>",
+	}
+
+	tests := []struct {
+		m      Money
+		parens bool
+		want   string
+	}{
+		{New(-105, usd), false, "<red>-$1.05</red>"},
+		{New(-105, usd), true, "<red>($1.05)</red>"},
+		{New(105, usd), false, "$1.05"},
+		{Zero(usd), true, "$0.00"},
+	}
+	for _, tt := range tests {
+		c := cfg
+		c.NegativeParens = tt.parens
+		got, err := tt.m.Format(c)
+		if err != nil {
+			t.Fatalf("format: %v", err)
+		}
+		if got != tt.want {
+			t.Fatalf("format %d = %q, want %q", tt.m.Amount(), got, tt.want)
+		}
+	}
+
+	compact, err := New(-123456789, usd).FormatCompact(cfg)
+	if err != nil || compact != "<red>-$1.2M</red>" {
+		t.Fatalf("format compact = %q, %v", compact, err)
+	}
+
+	col, err := FormatColumn([]Money{New(-105, usd), New(10000, usd)}, cfg)
+	if err != nil {
+		t.Fatalf("format column: %v", err)
+	}
+	if col[0] != " <red>-$1.05</red>" || col[1] != "$100.00" {
+		t.Fatalf("format column = %q", col)
+	}
+
+	cfg.NegativeSuffix = "\xff"
+	if _, err := New(-1, usd).Format(cfg); err != ErrInvalidOperation {
+		t.Fatalf("expected ErrInvalidOperation, got %v", err)
+	}
+}
+
+func TestFormatRTL(t *testing.T) {
+	ils := Currency{Code: "ILS", Scale: 2, Symbol: "₪"}
+	cfg := FormatConfig{DecimalSeparator: ".", ThousandsSeparator: ",", SymbolPosition: SymbolSuffix, SymbolKind: SymbolUseCurrencySymbol, Space: true}
+	rtl := cfg
+	rtl.RTL = true
+
+	tests := []struct {
+		m      Money
+		parens bool
+		want   string
+	}{
+		{New(123456, ils), false, "\u200e1,234.56\u200e ₪"},
+		{New(-123456, ils), false, "\u200e-\u200e1,234.56\u200e ₪"},
+		{New(-123456, ils), true, "(\u200e1,234.56\u200e ₪)"},
+	}
+	for _, tt := range tests {
+		c := rtl
+		c.NegativeParens = tt.parens
+		got, err := tt.m.Format(c)
+		if err != nil {
+			t.Fatalf("format: %v", err)
+		}
+		if got != tt.want {
+			t.Fatalf("format %d = %q, want %q", tt.m.Amount(), got, tt.want)
+		}
+		c.RTL = false
+		ltr, err := tt.m.Format(c)
+		if err != nil {
+			t.Fatalf("format: %v", err)
+		}
+		if visible := strings.ReplaceAll(got, "\u200e", ""); visible != ltr {
+			t.Fatalf("visible RTL text %q differs from %q", visible, ltr)
+		}
+	}
+
+	col, err := FormatColumn([]Money{New(5, ils), New(-123456, ils)}, rtl)
+	if err != nil {
+		t.Fatalf("format column: %v", err)
+	}
+	if col[0] != "     \u200e0.05\u200e ₪" {
+		t.Fatalf("format column = %q", col)
+	}
+}
+
+// TestFormatNegativeParensPlacement pins the placement rules for accounting
+// negatives: the parentheses enclose the symbol and any space, the minus sign
+// otherwise leads the whole string, and non-negative values are unaffected.
+func TestFormatNegativeParensPlacement(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+
+	tests := []struct {
+		pos    SymbolPosition
+		space  bool
+		group  bool
+		parens bool
+		amount int64
+		want   string
+	}{
+		{SymbolPrefix, false, false, false, -123456, "-$1234.56"},
+		{SymbolPrefix, false, false, false, 123456, "$1234.56"},
+		{SymbolPrefix, false, false, true, -123456, "($1234.56)"},
+		{SymbolPrefix, false, false, true, 123456, "$1234.56"},
+		{SymbolPrefix, false, true, false, -123456, "-$1,234.56"},
+		{SymbolPrefix, false, true, false, 123456, "$1,234.56"},
+		{SymbolPrefix, false, true, true, -123456, "($1,234.56)"},
+		{SymbolPrefix, false, true, true, 123456, "$1,234.56"},
+		{SymbolPrefix, true, false, false, -123456, "-$ 1234.56"},
+		{SymbolPrefix, true, false, false, 123456, "$ 1234.56"},
+		{SymbolPrefix, true, false, true, -123456, "($ 1234.56)"},
+		{SymbolPrefix, true, false, true, 123456, "$ 1234.56"},
+		{SymbolPrefix, true, true, false, -123456, "-$ 1,234.56"},
+		{SymbolPrefix, true, true, false, 123456, "$ 1,234.56"},
+		{SymbolPrefix, true, true, true, -123456, "($ 1,234.56)"},
+		{SymbolPrefix, true, true, true, 123456, "$ 1,234.56"},
+		{SymbolSuffix, false, false, false, -123456, "-1234.56$"},
+		{SymbolSuffix, false, false, false, 123456, "1234.56$"},
+		{SymbolSuffix, false, false, true, -123456, "(1234.56$)"},
+		{SymbolSuffix, false, false, true, 123456, "1234.56$"},
+		{SymbolSuffix, false, true, false, -123456, "-1,234.56$"},
+		{SymbolSuffix, false, true, false, 123456, "1,234.56$"},
+		{SymbolSuffix, false, true, true, -123456, "(1,234.56$)"},
+		{SymbolSuffix, false, true, true, 123456, "1,234.56$"},
+		{SymbolSuffix, true, false, false, -123456, "-1234.56 $"},
+		{SymbolSuffix, true, false, false, 123456, "1234.56 $"},
+		{SymbolSuffix, true, false, true, -123456, "(1234.56 $)"},
+		{SymbolSuffix, true, false, true, 123456, "1234.56 $"},
+		{SymbolSuffix, true, true, false, -123456, "-1,234.56 $"},
+		{SymbolSuffix, true, true, false, 123456, "1,234.56 $"},
+		{SymbolSuffix, true, true, true, -123456, "(1,234.56 $)"},
+		{SymbolSuffix, true, true, true, 123456, "1,234.56 $"},
+	}
+	for _, tt := range tests {
+		cfg := FormatConfig{DecimalSeparator: ".", SymbolPosition: tt.pos, SymbolKind: SymbolUseCurrencySymbol, Space: tt.space, NegativeParens: tt.parens}
+		if tt.group {
+			cfg.ThousandsSeparator = ","
+		}
+		got, err := New(tt.amount, usd).Format(cfg)
+		if err != nil {
+			t.Fatalf("format %+v: %v", tt, err)
+		}
+		if got != tt.want {
+			t.Fatalf("format pos=%d space=%v group=%v parens=%v %d = %q, want %q", tt.pos, tt.space, tt.group, tt.parens, tt.amount, got, tt.want)
+		}
+	}
+}
+
+func TestFormatNegativeParensEdges(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+	bare := Currency{Code: "XTS", Scale: 2}
+	xau := Currency{Code: "XAU", Scale: 3, Symbol: "oz"}
+	parens := FormatConfig{DecimalSeparator: ".", SymbolKind: SymbolUseCurrencySymbol, Space: true, NegativeParens: true}
+
+	tests := []struct {
+		name string
+		m    Money
+		cfg  FormatConfig
+		want string
+	}{
+		{"no symbol drops the space", New(-105, bare), parens, "(1.05)"},
+		{"code symbol", New(-105, usd), parens.With(WithSymbolCode(), WithSuffix()), "(1.05 USD)"},
+		{"zero text wins", Zero(usd), parens.With(WithZeroText("nil")), "nil"},
+		{"display zero is not negative", New(-5, xau), parens.With(WithMaxFractionDigits(2)), "oz 0.00"},
+		{"rounded negative keeps parens", New(-6, xau), parens.With(WithMaxFractionDigits(2)), "(oz 0.01)"},
+		{"trimmed fraction", New(-100, usd), parens.With(WithTrimTrailingZeros(true)), "($ 1)"},
+		{"min integer digits inside", New(-105, usd), parens.With(WithMinIntegerDigits(3)), "($ 001.05)"},
+		{"negative wrap outside parens", New(-105, usd), parens.With(WithNegativePrefix("<"), WithNegativeSuffix(">")), "<($ 1.05)>"},
+		{"custom symbol", New(-105, usd), parens.With(WithCustomSymbol("US$"), WithSpace(false)), "(US$1.05)"},
+	}
+	for _, tt := range tests {
+		got, err := tt.m.Format(tt.cfg)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if got != tt.want {
+			t.Fatalf("%s = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestSetFormatTemp(t *testing.T) {
+	orig := DefaultFormat()
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+
+	restore, err := SetFormatTemp(EUFormat())
+	if err != nil {
+		t.Fatalf("set format temp: %v", err)
+	}
+	if got := New(123456, usd).String(); got != "1.234,56 $" {
+		t.Fatalf("string under temp format = %q", got)
+	}
+	inner, err := SetFormatTemp(CodeSuffixFormat())
+	if err != nil {
+		t.Fatalf("set format temp: %v", err)
+	}
+	inner()
+	if DefaultFormat() != EUFormat() {
+		t.Fatalf("inner restore = %+v, want EUFormat", DefaultFormat())
+	}
+	restore()
+	if DefaultFormat() != orig {
+		t.Fatalf("restore = %+v, want %+v", DefaultFormat(), orig)
+	}
+
+	restore, err = SetFormatTemp(FormatConfig{})
+	if err != ErrInvalidOperation || restore != nil {
+		t.Fatalf("expected ErrInvalidOperation and nil restore, got %v", err)
+	}
+	if DefaultFormat() != orig {
+		t.Fatalf("invalid config changed the default")
+	}
+}
+
+func TestFormatMagnitude(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+	cfg := FormatConfig{
+		DecimalSeparator:   ".",
+		ThousandsSeparator: ",",
+		SymbolKind:         SymbolUseCurrencySymbol,
+		NegativeParens:     true,
+		NegativePrefix:     "<",
+		NegativeSuffix:     ">",
+	}
+
+	tests := []struct {
+		m    Money
+		want string
+	}{
+		{New(-123456, usd), "$1,234.56"},
+		{New(123456, usd), "$1,234.56"},
+		{New(math.MinInt64, usd), "$92,233,720,368,547,758.08"},
+	}
+	for _, tt := range tests {
+		got, err := tt.m.FormatMagnitude(cfg)
+		if err != nil {
+			t.Fatalf("format magnitude: %v", err)
+		}
+		if got != tt.want {
+			t.Fatalf("format magnitude %d = %q, want %q", tt.m.Amount(), got, tt.want)
+		}
+		if strings.ContainsAny(got, "-()<>") {
+			t.Fatalf("format magnitude %q has a sign indicator", got)
+		}
+	}
+
+	if _, err := New(-1, usd).FormatMagnitude(FormatConfig{}); err != ErrInvalidOperation {
+		t.Fatalf("expected ErrInvalidOperation, got %v", err)
+	}
+}
+
+func TestFormatSuperscriptFraction(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+	jpy := Currency{Code: "JPY", Scale: 0, Symbol: "¥"}
+	cfg := FormatConfig{DecimalSeparator: ".", ThousandsSeparator: ",", SymbolKind: SymbolUseCurrencySymbol, SuperscriptFraction: true}
+
+	tests := []struct {
+		m    Money
+		cfg  FormatConfig
+		want string
+	}{
+		{New(1050, usd), cfg, "$10⁵⁰"},
+		{New(-123409, usd), cfg, "-$1,234⁰⁹"},
+		{New(1234567, usd), cfg.With(WithSuffix(), WithSymbolCode(), WithSpace(true)), "12,345⁶⁷ USD"},
+		{New(1000, usd), cfg.With(WithTrimTrailingZeros(true)), "$10"},
+		{New(1234, jpy), cfg, "¥1,234"},
+	}
+	for _, tt := range tests {
+		got, err := tt.m.Format(tt.cfg)
+		if err != nil {
+			t.Fatalf("format: %v", err)
+		}
+		if got != tt.want {
+			t.Fatalf("format %d = %q, want %q", tt.m.Amount(), got, tt.want)
+		}
+	}
+
+	parts, err := New(1050, usd).FormatParts(cfg)
+	if err != nil {
+		t.Fatalf("format parts: %v", err)
+	}
+	if parts.Integer != "10" || parts.Symbol != "$" || parts.DecimalSeparator != "" || parts.Fraction != "⁵⁰" {
+		t.Fatalf("parts = %+v", parts)
+	}
+}
+
+func TestFormatScientific(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+	jpy := Currency{Code: "JPY", Scale: 0, Symbol: "¥"}
+	cfg := FormatConfig{DecimalSeparator: ".", ThousandsSeparator: ",", SymbolKind: SymbolUseCurrencySymbol, Scientific: true, ScientificThreshold: 1000000000}
+
+	tests := []struct {
+		m    Money
+		cfg  FormatConfig
+		want string
+	}{
+		{New(123456789000, usd), cfg, "$1.23e9"},
+		{New(99999999999, usd), cfg, "$999,999,999.99"},
+		{New(100000000000, usd), cfg, "$1.00e9"},
+		{New(-123456789000, usd), cfg, "-$1.23e9"},
+		{New(-123456789000, usd), cfg.With(WithNegativeParens(true)), "($1.23e9)"},
+		{New(123456789000, usd), cfg.With(WithSuffix(), WithSymbolCode(), WithSpace(true), WithDecimalSeparator(","), WithThousandsSeparator(".")), "1,23e9 USD"},
+		{New(999500000000, usd), cfg, "$1.00e10"},
+		{New(122500000000, usd), cfg, "$1.22e9"},
+		{New(123500000000, usd), cfg, "$1.24e9"},
+		{New(122500000001, usd), cfg, "$1.23e9"},
+		{New(123456789000, usd), cfg.With(WithSignificantDigits(1)), "$1e9"},
+		{New(123456789000, usd), cfg.With(WithSignificantDigits(6)), "$1.23457e9"},
+		{New(5, usd), cfg.With(WithScientific(0)), "$5.00e-2"},
+		{Zero(usd), cfg.With(WithScientific(0)), "$0.00"},
+		{New(math.MinInt64, jpy), cfg, "-¥9.22e18"},
+	}
+	for _, tt := range tests {
+		got, err := tt.m.Format(tt.cfg)
+		if err != nil {
+			t.Fatalf("format: %v", err)
+		}
+		if got != tt.want {
+			t.Fatalf("format %d = %q, want %q", tt.m.Amount(), got, tt.want)
+		}
+	}
+
+	for _, tt := range []struct {
+		m    Money
+		cfg  FormatConfig
+		want string
+	}{
+		{New(-123456789000, usd), cfg, "$1.23e9"},
+		{New(-123456789000, usd), cfg.With(WithNegativeParens(true)), "$1.23e9"},
+		{New(-99999999999, usd), cfg, "$999,999,999.99"},
+		{New(math.MinInt64, jpy), cfg, "¥9.22e18"},
+	} {
+		got, err := tt.m.FormatMagnitude(tt.cfg)
+		if err != nil {
+			t.Fatalf("format magnitude: %v", err)
+		}
+		if got != tt.want {
+			t.Fatalf("format magnitude %d = %q, want %q", tt.m.Amount(), got, tt.want)
+		}
+	}
+
+	for _, bad := range []FormatConfig{cfg.With(WithScientific(-1)), cfg.With(WithSignificantDigits(20))} {
+		if _, err := New(1, usd).Format(bad); err != ErrInvalidOperation {
+			t.Fatalf("expected ErrInvalidOperation, got %v", err)
+		}
+	}
+}
+
+func TestFormatDebitCredit(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+	ledger := Currency{Code: "USD", Scale: 2}
+	cfg := USDFormat().With(WithDebitCredit(false))
+
+	tests := []struct {
+		m    Money
+		cfg  FormatConfig
+		want string
+	}{
+		{New(100000, ledger), cfg, "1,000.00 DR"},
+		{New(-100000, ledger), cfg, "1,000.00 CR"},
+		{New(100000, ledger), cfg.With(WithDebitCredit(true)), "1,000.00 CR"},
+		{New(-100000, ledger), cfg.With(WithDebitCredit(true)), "1,000.00 DR"},
+		{Zero(ledger), cfg, "0.00"},
+		{New(-1050, usd), cfg, "$10.50 CR"},
+		{New(-1050, usd), CodeSuffixFormat().With(WithDebitCredit(false)), "10.50 USD CR"},
+	}
+	for _, tt := range tests {
+		got, err := tt.m.Format(tt.cfg)
+		if err != nil {
+			t.Fatalf("format: %v", err)
+		}
+		if got != tt.want {
+			t.Fatalf("format %d = %q, want %q", tt.m.Amount(), got, tt.want)
+		}
+	}
+
+	if _, err := New(1, usd).Format(cfg.With(WithNegativeParens(true))); err != ErrInvalidOperation {
+		t.Fatalf("expected ErrInvalidOperation, got %v", err)
+	}
+}
+
+func TestFormatSymbolOrCode(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+	xts := Currency{Code: "XTS", Scale: 2}
+	cfg := USDFormat().With(WithSymbolKind(SymbolUseSymbolOrCode))
+
+	tests := []struct {
+		m    Money
+		cfg  FormatConfig
+		want string
+	}{
+		{New(123456, usd), cfg, "$1,234.56"},
+		{New(123456, xts), cfg, "XTS1,234.56"},
+		{New(-123456, xts), cfg.With(WithSuffix(), WithSpace(true)), "-1,234.56 XTS"},
+	}
+	for _, tt := range tests {
+		got, err := tt.m.Format(tt.cfg)
+		if err != nil {
+			t.Fatalf("format: %v", err)
+		}
+		if got != tt.want {
+			t.Fatalf("format %s = %q, want %q", tt.m.Currency().Code, got, tt.want)
+		}
+	}
+}
+
+func TestFormatSymbolPadWidth(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+	chf := Currency{Code: "CHF", Scale: 2, Symbol: "CHF"}
+	cfg := USDFormat().With(WithSymbolPadWidth(3))
+
+	tests := []struct {
+		m    Money
+		cfg  FormatConfig
+		want string
+	}{
+		{New(123456, usd), cfg, "$  1,234.56"},
+		{New(123456, chf), cfg, "CHF1,234.56"},
+		{New(-1050, usd), cfg.With(WithSpace(true)), "-$   10.50"},
+		{New(-1050, chf), cfg.With(WithSpace(true)), "-CHF 10.50"},
+		{New(1050, usd), cfg.With(WithSuffix(), WithSpace(true)), "10.50   $"},
+		{New(1050, chf), cfg.With(WithSuffix(), WithSpace(true)), "10.50 CHF"},
+		{New(1050, chf), cfg.With(WithSymbolPadWidth(1)), "CHF10.50"},
+	}
+	for _, tt := range tests {
+		got, err := tt.m.Format(tt.cfg)
+		if err != nil {
+			t.Fatalf("format: %v", err)
+		}
+		if got != tt.want {
+			t.Fatalf("format %s = %q, want %q", tt.m.Currency().Code, got, tt.want)
+		}
+	}
+
+	if _, err := New(1, usd).Format(cfg.With(WithSymbolPadWidth(-1))); err != ErrInvalidOperation {
+		t.Fatalf("expected ErrInvalidOperation, got %v", err)
+	}
+}
+
+func TestTabString(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+	chf := Currency{Code: "CHF", Scale: 2, Symbol: "CHF"}
+
+	tests := []struct {
+		m    Money
+		cfg  FormatConfig
+		want string
+	}{
+		{New(1050, usd), USDFormat(), "$\t10.50"},
+		{New(-123456, usd), USDFormat().With(WithSpace(true)), "$\t-1,234.56"},
+		{New(-1050, usd), USDFormat().With(WithNegativeParens(true)), "$\t(10.50)"},
+		{New(-1050, chf), EUFormat(), "-10,50\tCHF"},
+		{Zero(usd), USDFormat().With(WithZeroText("-")), "$\t-"},
+	}
+	for _, tt := range tests {
+		got := tt.m.TabString(tt.cfg)
+		if got != tt.want {
+			t.Fatalf("tab string %d = %q, want %q", tt.m.Amount(), got, tt.want)
+		}
+		if n := strings.Count(got, "\t"); n != 1 {
+			t.Fatalf("tab string %q has %d tabs", got, n)
+		}
+	}
+
+	var buf strings.Builder
+	w := tabwriter.NewWriter(&buf, 0, 0, 1, ' ', tabwriter.AlignRight)
+	for _, m := range []Money{New(1050, usd), New(-123456, usd), New(99, chf)} {
+		fmt.Fprintf(w, "%s\t\n", m.TabString(USDFormat()))
+	}
+	if err := w.Flush(); err != nil {
+		t.Fatalf("flush: %v", err)
+	}
+	want := "   $     10.50\n" +
+		"   $ -1,234.56\n" +
+		" CHF      0.99\n"
+	if buf.String() != want {
+		t.Fatalf("tabwriter output =\n%s\nwant\n%s", buf.String(), want)
+	}
+
+	if got := New(1, usd).TabString(FormatConfig{}); !strings.HasPrefix(got, "<invalid money") {
+		t.Fatalf("invalid config = %q", got)
+	}
+}
+
+func TestLockFormat(t *testing.T) {
+	orig := DefaultFormat()
+	t.Cleanup(func() {
+		formatMu.Lock()
+		formatLocked = false
+		formatMu.Unlock()
+		if err := SetFormat(orig); err != nil {
+			t.Fatalf("reset format: %v", err)
+		}
+	})
+
+	restore, err := SetFormatTemp(EUFormat())
+	if err != nil {
+		t.Fatalf("set format temp: %v", err)
+	}
+	LockFormat()
+	LockFormat()
+	if err := SetFormat(USDFormat()); !errors.Is(err, ErrFormatLocked) || !errors.Is(err, ErrInvalidOperation) {
+		t.Fatalf("expected ErrFormatLocked, got %v", err)
+	}
+	if _, err := SetFormatTemp(USDFormat()); !errors.Is(err, ErrFormatLocked) {
+		t.Fatalf("expected ErrFormatLocked, got %v", err)
+	}
+	restore()
+	if DefaultFormat() != EUFormat() {
+		t.Fatalf("locked format = %+v, want EUFormat", DefaultFormat())
+	}
+	if err := SetFormat(FormatConfig{}); err != ErrInvalidOperation {
+		t.Fatalf("expected ErrInvalidOperation for invalid config, got %v", err)
+	}
+}
+
+func TestApproxString(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+	jpy := Currency{Code: "JPY", Scale: 0, Symbol: "¥"}
+	bhd := Currency{Code: "BHD", Scale: 3}
+
+	tests := []struct {
+		m    Money
+		want string
+	}{
+		{Zero(usd), "0 USD"},
+		{New(1050, usd), "10.5 USD"},
+		{New(-99999, usd), "-999.99 USD"},
+		{New(123456, usd), "1.2K USD"},
+		{New(-123456789, usd), "-1.2M USD"},
+		{New(math.MaxInt64, usd), "92233.7T USD"},
+		{New(1234567, jpy), "1.2M JPY"},
+		{New(1005, bhd), "1 BHD"},
+		{New(1, Currency{Code: "USD", Scale: 40}), "<invalid money: USD scale=40 amount=1>"},
+	}
+	for _, tt := range tests {
+		if got := tt.m.ApproxString(); got != tt.want {
+			t.Fatalf("approx %d = %q, want %q", tt.m.Amount(), got, tt.want)
+		}
+	}
+}
+
+func TestFormatShowCode(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+	eur := Currency{Code: "EUR", Scale: 2, Symbol: "€"}
+	xts := Currency{Code: "XTS", Scale: 2}
+	us := USDFormat().With(WithShowCode(true))
+	eu := EUFormat().With(WithShowCode(true))
+
+	tests := []struct {
+		m    Money
+		cfg  FormatConfig
+		want string
+	}{
+		{New(123456, usd), us, "USD $1,234.56"},
+		{New(123456, eur), eu, "1.234,56 € EUR"},
+		{New(-123456, usd), us, "USD -$1,234.56"},
+		{New(-123456, usd), us.With(WithNegativeParens(true)), "USD ($1,234.56)"},
+		{New(-123456, eur), eu.With(WithNegativeParens(true)), "(1.234,56 €) EUR"},
+		{New(123456, usd), us.With(WithDebitCredit(false)), "USD $1,234.56 DR"},
+		{New(123456, xts), us.With(WithSymbolKind(SymbolUseSymbolOrCode)), "XTS1,234.56"},
+		{New(123456789, usd), us, "USD $1,234,567.89"},
+	}
+	for _, tt := range tests {
+		got, err := tt.m.Format(tt.cfg)
+		if err != nil {
+			t.Fatalf("format: %v", err)
+		}
+		if got != tt.want {
+			t.Fatalf("format %d = %q, want %q", tt.m.Amount(), got, tt.want)
+		}
+	}
+	if got, err := New(123456789, usd).FormatCompact(us); err != nil || got != "USD $1.2M" {
+		t.Fatalf("compact = %q, %v", got, err)
+	}
+	if got := New(-1050, eur).TabString(eu); got != "-10,50\t€ EUR" {
+		t.Fatalf("tab string = %q", got)
+	}
+
+	if _, err := New(1, usd).Format(us.With(WithSymbolCode())); err != ErrInvalidOperation {
+		t.Fatalf("expected ErrInvalidOperation, got %v", err)
+	}
+}
+
+func TestFormatNumeralSystem(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+	arabic := USDFormat().With(WithNumeralSystem(NumeralsEasternArabic))
+
+	tests := []struct {
+		m    Money
+		cfg  FormatConfig
+		want string
+	}{
+		{New(123456789, usd), arabic, "$١,٢٣٤,٥٦٧.٨٩"},
+		{New(-1050, usd), arabic, "-$١٠.٥٠"},
+		{New(123456, usd), arabic.With(WithThousandsSeparator("٬"), WithDecimalSeparator("٫")), "$١٬٢٣٤٫٥٦"},
+		{New(123456, usd), USDFormat().With(WithNumeralSystem(NumeralsDevanagari)), "$१,२३४.५६"},
+		{New(123456789, usd), arabic.With(WithScientific(1000)), "$١.٢٣e٦"},
+		{Zero(usd), arabic.With(WithZeroText("0")), "0"},
+	}
+	for _, tt := range tests {
+		got, err := tt.m.Format(tt.cfg)
+		if err != nil {
+			t.Fatalf("format: %v", err)
+		}
+		if got != tt.want {
+			t.Fatalf("format %d = %q, want %q", tt.m.Amount(), got, tt.want)
+		}
+	}
+
+	if _, err := New(1, usd).Format(USDFormat().With(WithNumeralSystem(3))); err != ErrInvalidOperation {
+		t.Fatalf("expected ErrInvalidOperation, got %v", err)
+	}
+}
+
+func TestFormatMinDigitsForGrouping(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+	cfg := USDFormat().With(WithMinDigitsForGrouping(5))
+
+	tests := []struct {
+		m    Money
+		cfg  FormatConfig
+		want string
+	}{
+		{New(100000, usd), cfg, "$1000.00"},
+		{New(-999999, usd), cfg, "-$9999.99"},
+		{New(1000000, usd), cfg, "$10,000.00"},
+		{New(123456789, usd), cfg, "$1,234,567.89"},
+		{New(100000, usd), USDFormat(), "$1,000.00"},
+		{New(100, usd), cfg.With(WithMinIntegerDigits(5)), "$00,001.00"},
+	}
+	for _, tt := range tests {
+		got, err := tt.m.Format(tt.cfg)
+		if err != nil {
+			t.Fatalf("format: %v", err)
+		}
+		if got != tt.want {
+			t.Fatalf("format %d = %q, want %q", tt.m.Amount(), got, tt.want)
+		}
+	}
+
+	if _, err := New(1, usd).Format(cfg.With(WithMinDigitsForGrouping(-1))); err != ErrInvalidOperation {
+		t.Fatalf("expected ErrInvalidOperation, got %v", err)
+	}
+}
+
+func TestFormatTable(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+	eur := Currency{Code: "EUR", Scale: 2, Symbol: "€"}
+	bhd := Currency{Code: "BHD", Scale: 3, Symbol: "BD"}
+	trim := USDFormat().With(WithTrimTrailingZeros(true))
+
+	tests := []struct {
+		name  string
+		items []Money
+		cfg   FormatConfig
+		want  []string
+	}{
+		{"mixed", []Money{New(1050, usd), New(1055, usd), New(1000, usd)}, trim, []string{"$10.50", "$10.55", "$10.00"}},
+		{"one digit", []Money{New(1050, usd), New(-123400, usd)}, trim, []string{"$10.5", "-$1,234.0"}},
+		{"whole", []Money{New(1000, usd), New(0, usd)}, trim, []string{"$10", "$0"}},
+		{"min fraction", []Money{New(1000, usd)}, trim.With(WithMinFractionDigits(1)), []string{"$10.0"}},
+		{"max fraction", []Money{New(1005, bhd), New(1500, bhd)}, trim.With(WithMaxFractionDigits(2)), []string{"BD1.0", "BD1.5"}},
+		{"superscript", []Money{New(1050, usd), New(1055, usd)}, trim.With(WithSuperscriptFraction(true)), []string{"$10⁵⁰", "$10⁵⁵"}},
+		{"untrimmed", []Money{New(1050, usd), New(1000, usd)}, USDFormat(), []string{"$10.50", "$10.00"}},
+		{"vulgar", []Money{New(1050, usd), New(1000, usd)}, trim.With(WithVulgarFraction(true)), []string{"$10 5/10", "$10 0/10"}},
+		{"empty", nil, trim, []string{}},
+	}
+	for _, tt := range tests {
+		got, err := FormatTable(tt.items, tt.cfg)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if len(got) != len(tt.want) {
+			t.Fatalf("%s: got %q, want %q", tt.name, got, tt.want)
+		}
+		for i := range tt.want {
+			if got[i] != tt.want[i] {
+				t.Fatalf("%s row %d = %q, want %q", tt.name, i, got[i], tt.want[i])
+			}
+		}
+	}
+
+	if _, err := FormatTable([]Money{New(1, usd), New(1, eur)}, trim); err != ErrCurrencyMismatch {
+		t.Fatalf("expected ErrCurrencyMismatch, got %v", err)
+	}
+	if _, err := FormatTable([]Money{New(1, usd)}, FormatConfig{}); err != ErrInvalidOperation {
+		t.Fatalf("expected ErrInvalidOperation, got %v", err)
+	}
+}
+
+func TestFormatVulgarFraction(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+	jpy := Currency{Code: "JPY", Scale: 0, Symbol: "¥"}
+	bhd := Currency{Code: "BHD", Scale: 3, Symbol: "BD"}
+	cfg := USDFormat().With(WithVulgarFraction(true))
+
+	tests := []struct {
+		m    Money
+		cfg  FormatConfig
+		want string
+	}{
+		{New(1050, usd), cfg, "$10 50/100"},
+		{New(-123405, usd), cfg, "-$1,234 05/100"},
+		{New(1050, jpy), cfg, "¥1,050"},
+		{New(10005, bhd), cfg, "BD10 005/1000"},
+		{New(1050, usd), cfg.With(WithTrimTrailingZeros(true)), "$10 5/10"},
+		{New(1000, usd), cfg.With(WithTrimTrailingZeros(true)), "$10"},
+		{New(1050, usd), cfg.With(WithSuffix(), WithSymbolCode(), WithSpace(true)), "10 50/100 USD"},
+	}
+	for _, tt := range tests {
+		got, err := tt.m.Format(tt.cfg)
+		if err != nil {
+			t.Fatalf("format: %v", err)
+		}
+		if got != tt.want {
+			t.Fatalf("format %d = %q, want %q", tt.m.Amount(), got, tt.want)
+		}
+	}
+
+	if _, err := New(1, usd).Format(cfg.With(WithSuperscriptFraction(true))); err != ErrInvalidOperation {
+		t.Fatalf("expected ErrInvalidOperation, got %v", err)
+	}
+}
+
+func TestFormatSymbolResolver(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+	pts := Currency{Code: "XPT", Scale: 0, MajorUnitName: "point"}
+	symbol := func(c Currency) string {
+		if c.MajorUnitName == "point" {
+			return "pts"
+		}
+		return c.Symbol
+	}
+	cfg := USDFormat().With(WithSymbolFunc(symbol))
+
+	tests := []struct {
+		m    Money
+		cfg  FormatConfig
+		want string
+	}{
+		{New(1500, pts), cfg.With(WithSuffix(), WithSpace(true)), "1,500 pts"},
+		{New(1050, usd), cfg, "$10.50"},
+		{New(1050, usd), cfg.With(WithSymbolCode()), "$10.50"},
+		{New(1050, usd), cfg.With(WithSymbolKind(SymbolUseCustom)), "$10.50"},
+		{New(1050, usd), cfg.With(WithSymbolFunc(func(c Currency) string { return c.Code + "!" })), "USD!10.50"},
+	}
+	for _, tt := range tests {
+		got, err := tt.m.Format(tt.cfg)
+		if err != nil {
+			t.Fatalf("format: %v", err)
+		}
+		if got != tt.want {
+			t.Fatalf("format %s = %q, want %q", tt.m.Currency().Code, got, tt.want)
+		}
+	}
+	if got := New(-1500, pts).TabString(cfg); got != "pts\t-1,500" {
+		t.Fatalf("tab string = %q", got)
+	}
+	if _, err := json.Marshal(cfg); err != nil {
+		t.Fatalf("marshal config with a resolver: %v", err)
+	}
+
+	resolver := &SymbolResolver{Func: symbol}
+	a, b := USDFormat().With(WithSymbolResolver(resolver)), USDFormat().With(WithSymbolResolver(resolver))
+	seen := map[FormatConfig]bool{a: true}
+	if a != b || !seen[b] || a == cfg {
+		t.Fatalf("configs sharing a resolver should be equal, and differ from another resolver")
+	}
+	if got, err := New(1050, usd).Format(USDFormat().With(WithSymbolResolver(&SymbolResolver{}))); err != nil || got != "$10.50" {
+		t.Fatalf("empty resolver = %q, %v", got, err)
+	}
+}
+
+func TestFormatCodeCase(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+	btc := Currency{Code: "BTC", Scale: 8}
+	mixed := Currency{Code: "Usd", Scale: 2}
+	lower := CodeSuffixFormat().With(WithCodeCase(CodeLower))
+
+	tests := []struct {
+		m    Money
+		cfg  FormatConfig
+		want string
+	}{
+		{New(123456, usd), lower, "1234.56 usd"},
+		{New(-123456, usd), lower, "-1234.56 usd"},
+		{New(150000000, btc), lower, "1.50000000 btc"},
+		{New(1050, mixed), CodeSuffixFormat(), "10.50 Usd"},
+		{New(1050, mixed), CodeSuffixFormat().With(WithCodeCase(CodeUpper)), "10.50 USD"},
+		{New(1050, usd), USDFormat().With(WithCodeCase(CodeLower)), "$10.50"},
+		{New(1050, usd), USDFormat().With(WithCodeCase(CodeLower), WithShowCode(true)), "usd $10.50"},
+		{New(1050, btc), USDFormat().With(WithCodeCase(CodeLower), WithSymbolKind(SymbolUseSymbolOrCode), WithShowCode(true)), "btc0.00001050"},
+	}
+	for _, tt := range tests {
+		got, err := tt.m.Format(tt.cfg)
+		if err != nil {
+			t.Fatalf("format: %v", err)
+		}
+		if got != tt.want {
+			t.Fatalf("format %s = %q, want %q", tt.m.Currency().Code, got, tt.want)
+		}
+	}
+
+	if _, err := New(1, usd).Format(lower.With(WithCodeCase(9))); err != ErrInvalidOperation {
+		t.Fatalf("expected ErrInvalidOperation, got %v", err)
+	}
+}
+
+func TestFormatMinDisplayScale(t *testing.T) {
+	jpy := Currency{Code: "JPY", Scale: 0, Symbol: "¥"}
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+	bhd := Currency{Code: "BHD", Scale: 3, Symbol: "BD"}
+	cfg := USDFormat().With(WithMinDisplayScale(2))
+
+	m := New(123, jpy)
+	tests := []struct {
+		m    Money
+		cfg  FormatConfig
+		want string
+	}{
+		{m, cfg, "¥123.00"},
+		{New(-123456, jpy), cfg, "-¥123,456.00"},
+		{New(1050, usd), cfg, "$10.50"},
+		{New(1050, usd), cfg.With(WithTrimTrailingZeros(true)), "$10.50"},
+		{New(1000, usd), cfg.With(WithTrimTrailingZeros(true)), "$10.00"},
+		{New(1000, usd), USDFormat().With(WithTrimTrailingZeros(true), WithMinDisplayScale(1)), "$10.0"},
+		{New(1500, bhd), cfg, "BD1.500"},
+		{New(1500, bhd), cfg.With(WithMaxFractionDigits(2)), "BD1.50"},
+		{m, EUFormat().With(WithMinDisplayScale(2)), "123,00 ¥"},
+	}
+	for _, tt := range tests {
+		got, err := tt.m.Format(tt.cfg)
+		if err != nil {
+			t.Fatalf("format: %v", err)
+		}
+		if got != tt.want {
+			t.Fatalf("format %d %s = %q, want %q", tt.m.Amount(), tt.m.Currency().Code, got, tt.want)
+		}
+	}
+	if m.Amount() != 123 || m.Currency().Scale != 0 {
+		t.Fatalf("stored amount changed: %d at scale %d", m.Amount(), m.Currency().Scale)
+	}
+
+	for _, bad := range []FormatConfig{
+		USDFormat().With(WithMinDisplayScale(-1)),
+		USDFormat().With(WithMinDisplayScale(3), WithMaxFractionDigits(2)),
+	} {
+		if _, err := m.Format(bad); err != ErrInvalidOperation {
+			t.Fatalf("expected ErrInvalidOperation, got %v", err)
+		}
+	}
+}
@@ -0,0 +1,284 @@
+package money
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ParseFormatDSL parses a compact FormatConfig description such as
+// "sym=code;sep=,;grp=.;pos=suffix;space", for command-line flags.
+//
+// Items are separated by ";" and are either key=value pairs or bare flags.
+// A backslash escapes the next character, so "grp=\;" uses ";" as the
+// thousands separator. Later items override earlier ones.
+//
+//	sym=symbol|code|custom|symbolorcode
+//	                        SymbolKind
+//	custom=TEXT             CustomSymbol
+//	sep=C                   DecimalSeparator (default ".")
+//	grp=C                   ThousandsSeparator
+//	pos=prefix|suffix       SymbolPosition
+//	minint=N                MinIntegerDigits
+//	minfrac=N               MinFractionDigits
+//	maxfrac=N               MaxFractionDigits
+//	zero=TEXT               ZeroText
+//	negprefix=TEXT          NegativePrefix
+//	negsuffix=TEXT          NegativeSuffix
+//	scimin=N                ScientificThreshold
+//	sigdigits=N             SignificantDigits
+//	sympad=N                SymbolPadWidth
+//	grpmin=N                MinDigitsForGrouping
+//	minscale=N              MinDisplayScale
+//	digits=latin|arabic|devanagari
+//	                        NumeralSystem
+//	codecase=asis|upper|lower
+//	                        CodeCase
+//	space, parens, trim, rtl, sup, sci, drcr, crpos, showcode, vulgar
+//	                        Space, NegativeParens, TrimTrailingZeros, RTL,
+//	                        SuperscriptFraction, Scientific, DebitCredit,
+//	                        CreditPositive, ShowCode, VulgarFraction
+//
+// Unknown keys, malformed values and configs rejected by Format return an
+// error wrapping ErrInvalidOperation.
+// Example: ParseFormatDSL("sep=,;grp=.;pos=suffix;space") -> EUFormat().
+func ParseFormatDSL(s string) (FormatConfig, error) {
+	cfg := FormatConfig{DecimalSeparator: "."}
+	items, err := splitDSL(s)
+	if err != nil {
+		return FormatConfig{}, err
+	}
+	for _, item := range items {
+		if err := applyDSLItem(&cfg, item); err != nil {
+			return FormatConfig{}, err
+		}
+	}
+	if err := validateFormat(cfg); err != nil {
+		return FormatConfig{}, fmt.Errorf("format DSL %q: %w", s, err)
+	}
+	return cfg, nil
+}
+
+// DSL renders c in the ParseFormatDSL grammar; ParseFormatDSL(c.DSL()) == c
+// for any valid config. Flags that are off and empty texts are omitted; a
+// SymbolResolver cannot be expressed and is dropped.
+// Example: EUFormat().DSL() -> "sym=symbol;sep=,;grp=.;pos=suffix;space".
+func (c FormatConfig) DSL() string {
+	kind := symbolKindNames[c.SymbolKind]
+	if kind == "" {
+		kind = strconv.Itoa(int(c.SymbolKind))
+	}
+	pos := symbolPositionNames[c.SymbolPosition]
+	if pos == "" {
+		pos = strconv.Itoa(int(c.SymbolPosition))
+	}
+	items := []string{"sym=" + kind}
+	text := func(key, value string) {
+		if value != "" {
+			items = append(items, key+"="+escapeDSL(value))
+		}
+	}
+	number := func(key string, value int) {
+		if value != 0 {
+			items = append(items, key+"="+strconv.Itoa(value))
+		}
+	}
+	flag := func(key string, on bool) {
+		if on {
+			items = append(items, key)
+		}
+	}
+	text("custom", c.CustomSymbol)
+	items = append(items, "sep="+escapeDSL(c.DecimalSeparator))
+	text("grp", c.ThousandsSeparator)
+	items = append(items, "pos="+pos)
+	flag("space", c.Space)
+	flag("parens", c.NegativeParens)
+	number("minint", c.MinIntegerDigits)
+	flag("trim", c.TrimTrailingZeros)
+	number("minfrac", c.MinFractionDigits)
+	number("maxfrac", c.MaxFractionDigits)
+	text("zero", c.ZeroText)
+	text("negprefix", c.NegativePrefix)
+	text("negsuffix", c.NegativeSuffix)
+	flag("rtl", c.RTL)
+	flag("sup", c.SuperscriptFraction)
+	flag("sci", c.Scientific)
+	if c.ScientificThreshold != 0 {
+		items = append(items, "scimin="+strconv.FormatInt(c.ScientificThreshold, 10))
+	}
+	number("sigdigits", c.SignificantDigits)
+	flag("drcr", c.DebitCredit)
+	flag("crpos", c.CreditPositive)
+	number("sympad", c.SymbolPadWidth)
+	flag("showcode", c.ShowCode)
+	number("grpmin", c.MinDigitsForGrouping)
+	flag("vulgar", c.VulgarFraction)
+	number("minscale", c.MinDisplayScale)
+	if c.CodeCase != CodeAsIs {
+		codeCase := codeCaseNames[c.CodeCase]
+		if codeCase == "" {
+			codeCase = strconv.Itoa(int(c.CodeCase))
+		}
+		items = append(items, "codecase="+codeCase)
+	}
+	if c.NumeralSystem != NumeralsLatin {
+		digits := numeralSystemNames[c.NumeralSystem]
+		if digits == "" {
+			digits = strconv.Itoa(int(c.NumeralSystem))
+		}
+		items = append(items, "digits="+digits)
+	}
+	return strings.Join(items, ";")
+}
+
+// dslItem is one parsed item; hasValue distinguishes "grp=" from a bare flag.
+type dslItem struct {
+	key, value string
+	hasValue   bool
+}
+
+// splitDSL splits on unescaped ";" and the first unescaped "=", dropping empty items.
+// Example: splitDSL(`grp=\;;space`) -> [{grp ; true} {space  false}].
+func splitDSL(s string) ([]dslItem, error) {
+	var items []dslItem
+	var cur dslItem
+	var b strings.Builder
+	flush := func() {
+		if cur.hasValue {
+			cur.value = b.String()
+		} else {
+			cur.key = b.String()
+		}
+		if cur.key != "" || cur.hasValue {
+			items = append(items, cur)
+		}
+		cur = dslItem{}
+		b.Reset()
+	}
+	for i := 0; i < len(s); i++ {
+		switch ch := s[i]; {
+		case ch == '\\':
+			if i+1 == len(s) {
+				return nil, fmt.Errorf("format DSL %q: trailing backslash: %w", s, ErrInvalidOperation)
+			}
+			i++
+			b.WriteByte(s[i])
+		case ch == ';':
+			flush()
+		case ch == '=' && !cur.hasValue:
+			cur.key, cur.hasValue = b.String(), true
+			b.Reset()
+		default:
+			b.WriteByte(ch)
+		}
+	}
+	flush()
+	return items, nil
+}
+
+func applyDSLItem(cfg *FormatConfig, item dslItem) error {
+	bad := func(reason string) error {
+		return fmt.Errorf("format DSL item %q: %s: %w", item.key, reason, ErrInvalidOperation)
+	}
+	flags := map[string]*bool{
+		"space":    &cfg.Space,
+		"parens":   &cfg.NegativeParens,
+		"trim":     &cfg.TrimTrailingZeros,
+		"rtl":      &cfg.RTL,
+		"sup":      &cfg.SuperscriptFraction,
+		"sci":      &cfg.Scientific,
+		"drcr":     &cfg.DebitCredit,
+		"crpos":    &cfg.CreditPositive,
+		"showcode": &cfg.ShowCode,
+		"vulgar":   &cfg.VulgarFraction,
+	}
+	if dst, ok := flags[item.key]; ok {
+		if item.hasValue {
+			return bad("flag takes no value")
+		}
+		*dst = true
+		return nil
+	}
+	if !item.hasValue {
+		return bad("missing value")
+	}
+	numbers := map[string]*int{
+		"minint":    &cfg.MinIntegerDigits,
+		"minfrac":   &cfg.MinFractionDigits,
+		"maxfrac":   &cfg.MaxFractionDigits,
+		"sigdigits": &cfg.SignificantDigits,
+		"sympad":    &cfg.SymbolPadWidth,
+		"grpmin":    &cfg.MinDigitsForGrouping,
+		"minscale":  &cfg.MinDisplayScale,
+	}
+	if dst, ok := numbers[item.key]; ok {
+		n, err := strconv.Atoi(item.value)
+		if err != nil {
+			return bad("not an integer")
+		}
+		*dst = n
+		return nil
+	}
+	switch item.key {
+	case "scimin":
+		n, err := strconv.ParseInt(item.value, 10, 64)
+		if err != nil {
+			return bad("not an integer")
+		}
+		cfg.ScientificThreshold = n
+	case "sym":
+		for kind, name := range symbolKindNames {
+			if name == item.value {
+				cfg.SymbolKind = kind
+				return nil
+			}
+		}
+		return bad("unknown symbol kind")
+	case "pos":
+		for pos, name := range symbolPositionNames {
+			if name == item.value {
+				cfg.SymbolPosition = pos
+				return nil
+			}
+		}
+		return bad("unknown symbol position")
+	case "codecase":
+		for codeCase, name := range codeCaseNames {
+			if name == item.value {
+				cfg.CodeCase = codeCase
+				return nil
+			}
+		}
+		return bad("unknown code case")
+	case "digits":
+		for system, name := range numeralSystemNames {
+			if name == item.value {
+				cfg.NumeralSystem = system
+				return nil
+			}
+		}
+		return bad("unknown numeral system")
+	case "custom":
+		cfg.CustomSymbol = item.value
+	case "sep":
+		cfg.DecimalSeparator = item.value
+	case "grp":
+		cfg.ThousandsSeparator = item.value
+	case "zero":
+		cfg.ZeroText = item.value
+	case "negprefix":
+		cfg.NegativePrefix = item.value
+	case "negsuffix":
+		cfg.NegativeSuffix = item.value
+	default:
+		return bad("unknown key")
+	}
+	return nil
+}
+
+// escapeDSL backslash-escapes the DSL metacharacters in a value.
+// Example: escapeDSL("a;b") -> `a\;b`.
+func escapeDSL(s string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, "=", `\=`).Replace(s)
+}
@@ -0,0 +1,77 @@
+package money
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestFormatDSLRoundTrip(t *testing.T) {
+	full := FormatConfig{
+		DecimalSeparator:     ";",
+		ThousandsSeparator:   "=",
+		SymbolPosition:       SymbolSuffix,
+		SymbolKind:           SymbolUseCustom,
+		CustomSymbol:         `a\b;c`,
+		Space:                true,
+		NegativeParens:       true,
+		MinIntegerDigits:     3,
+		TrimTrailingZeros:    true,
+		MinFractionDigits:    1,
+		MaxFractionDigits:    2,
+		ZeroText:             "free",
+		NegativePrefix:       "\x1b[31m",
+		NegativeSuffix:       "\x1b[0m",
+		RTL:                  true,
+		SuperscriptFraction:  true,
+		Scientific:           true,
+		ScientificThreshold:  1000000,
+		SignificantDigits:    4,
+		SymbolPadWidth:       3,
+		ShowCode:             true,
+		NumeralSystem:        NumeralsDevanagari,
+		MinDigitsForGrouping: 5,
+		CodeCase:             CodeLower,
+		MinDisplayScale:      2,
+	}
+	for _, cfg := range []FormatConfig{full, USDFormat().With(WithDebitCredit(true), WithVulgarFraction(true)), USDFormat(), EUFormat(), CodeSuffixFormat(), localeFormats["fr-FR"]} {
+		back, err := ParseFormatDSL(cfg.DSL())
+		if err != nil {
+			t.Fatalf("parse %q: %v", cfg.DSL(), err)
+		}
+		if back != cfg {
+			t.Fatalf("round trip %q = %+v, want %+v", cfg.DSL(), back, cfg)
+		}
+	}
+
+	if got, want := EUFormat().DSL(), "sym=symbol;sep=,;grp=.;pos=suffix;space"; got != want {
+		t.Fatalf("EUFormat DSL = %q, want %q", got, want)
+	}
+	cfg, err := ParseFormatDSL("sym=code;sep=,;grp=.;pos=suffix;space;")
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if cfg != EUFormat().With(WithSymbolCode()) {
+		t.Fatalf("parse = %+v", cfg)
+	}
+	if cfg, err := ParseFormatDSL(""); err != nil || cfg != (FormatConfig{DecimalSeparator: "."}) {
+		t.Fatalf("empty DSL = %+v, %v", cfg, err)
+	}
+}
+
+func TestFormatDSLErrors(t *testing.T) {
+	for _, s := range []string{
+		"color=red",
+		"sym=emoji",
+		"pos=middle",
+		"space=yes",
+		"sep",
+		"minint=two",
+		"sep=,;grp=,",
+		"sym=custom",
+		"sep=\\",
+	} {
+		if _, err := ParseFormatDSL(s); !errors.Is(err, ErrInvalidOperation) {
+			t.Fatalf("parse %q: expected ErrInvalidOperation, got %v", s, err)
+		}
+	}
+}
@@ -2,4 +2,7 @@ module github.com/Opvra/go-money
 
 go 1.22
 
-require github.com/govalues/decimal v0.1.36
+require (
+	github.com/google/go-cmp v0.7.0
+	github.com/govalues/decimal v0.1.36
+)
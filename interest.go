@@ -0,0 +1,19 @@
+package money
+
+import "github.com/Opvra/go-money/internal/calc"
+
+// AccrueSimpleInterest returns the simple interest earned on m over the given
+// number of days: principal * annualRateBps/10000 * days/dayBasis, computed
+// exactly and rounded half to even to the currency scale. dayBasis must be 360
+// or 365; the rate and days must be non-negative.
+// Example: New(100000000, USD).AccrueSimpleInterest(500, 90, 360) -> 1250000.
+func (m Money) AccrueSimpleInterest(annualRateBps int64, days int, dayBasis int) (interest Money, err error) {
+	if dayBasis != 360 && dayBasis != 365 {
+		return Money{}, ErrInvalidOperation
+	}
+	amount, err := calc.SimpleInterest(m.amount, annualRateBps, int64(days), int64(dayBasis))
+	if err != nil {
+		return Money{}, ErrInvalidOperation
+	}
+	return Money{amount: amount, currency: m.currency}, nil
+}
@@ -0,0 +1,57 @@
+package money
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAccrueSimpleInterest(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+
+	tests := []struct {
+		principal int64
+		bps       int64
+		days      int
+		basis     int
+		want      int64
+	}{
+		// 1,000,000.00 * 5% * 90/360 = 12,500.00
+		{100000000, 500, 90, 360, 1250000},
+		// 10,000.00 * 5.25% * 31/365 = 44.5890... -> 44.59
+		{1000000, 525, 31, 365, 4459},
+		{-1000000, 525, 31, 365, -4459},
+		// exact ties: 0.36 * 50% * 10/360 = 0.005 -> 0.00; 1.08 -> 0.015 -> 0.02
+		{36, 5000, 10, 360, 0},
+		{108, 5000, 10, 360, 2},
+		{1000000, 500, 0, 365, 0},
+		{math.MaxInt64, 100, 365, 365, 92233720368547758},
+	}
+	for _, tt := range tests {
+		got, err := New(tt.principal, usd).AccrueSimpleInterest(tt.bps, tt.days, tt.basis)
+		if err != nil {
+			t.Fatalf("accrue %d: %v", tt.principal, err)
+		}
+		if got.Amount() != tt.want || got.Currency() != usd {
+			t.Fatalf("accrue %d at %d bps for %d/%d = %d, want %d", tt.principal, tt.bps, tt.days, tt.basis, got.Amount(), tt.want)
+		}
+	}
+
+	errs := []struct {
+		bps   int64
+		days  int
+		basis int
+	}{
+		{500, 30, 364},
+		{-1, 30, 360},
+		{500, -1, 360},
+		{math.MaxInt64, 2, 360},
+	}
+	for _, tt := range errs {
+		if _, err := New(100, usd).AccrueSimpleInterest(tt.bps, tt.days, tt.basis); err != ErrInvalidOperation {
+			t.Fatalf("accrue %+v: expected ErrInvalidOperation, got %v", tt, err)
+		}
+	}
+	if _, err := New(math.MaxInt64, usd).AccrueSimpleInterest(20000, 365, 365); err != ErrInvalidOperation {
+		t.Fatalf("expected ErrInvalidOperation on overflow, got %v", err)
+	}
+}
@@ -0,0 +1,110 @@
+package calc
+
+import (
+	"errors"
+	"math/big"
+	"math/bits"
+	"sort"
+)
+
+var errWeights = errors.New("invalid allocation weights")
+
+// Allocate splits value across weights so the parts always sum to value.
+// Each part starts as the truncated share of the magnitude; leftover minor
+// units go one each to weighted buckets, either in order or, with
+// largestRemainder, to the buckets with the largest fractional remainders.
+// Negative values are allocated by magnitude and the sign applied to every part.
+// Example: Allocate(101, []int64{10, 45, 45}, false) -> [11, 45, 45].
+// Example: Allocate(101, []int64{10, 45, 45}, true) -> [10, 46, 45].
+func Allocate(value int64, weights []int64, largestRemainder bool) ([]int64, error) {
+	var total uint64
+	for _, w := range weights {
+		if w < 0 {
+			return nil, errWeights
+		}
+		var carry uint64
+		total, carry = bits.Add64(total, uint64(w), 0)
+		if carry != 0 {
+			return nil, errWeights
+		}
+	}
+	if total == 0 {
+		return nil, errWeights
+	}
+
+	abs := absInt64(value)
+	shares := make([]uint64, len(weights))
+	rems := make([]uint64, len(weights))
+	left := abs
+	for i, w := range weights {
+		hi, lo := bits.Mul64(abs, uint64(w))
+		shares[i], rems[i] = bits.Div64(hi, lo, total)
+		left -= shares[i]
+	}
+
+	order := make([]int, 0, len(weights))
+	for i, w := range weights {
+		if w > 0 {
+			order = append(order, i)
+		}
+	}
+	if largestRemainder {
+		sort.SliceStable(order, func(a, b int) bool {
+			return rems[order[a]] > rems[order[b]]
+		})
+	}
+	for _, i := range order {
+		if left == 0 {
+			break
+		}
+		shares[i]++
+		left--
+	}
+
+	out := make([]int64, len(weights))
+	for i, share := range shares {
+		out[i] = int64(share)
+		if value < 0 {
+			// share <= 2^63, so negation also covers math.MinInt64.
+			out[i] = -int64(share)
+		}
+	}
+	return out, nil
+}
+
+// WeightedAverage returns sum(values[i]*weights[i]) / sum(weights), computed
+// exactly and rounded half to even. Weights must be non-negative with a
+// positive total, and the slices must have equal lengths.
+// Example: WeightedAverage([]int64{1000, 1300}, []int64{1, 2}) -> 1200.
+func WeightedAverage(values, weights []int64) (int64, error) {
+	if len(values) != len(weights) {
+		return 0, errWeights
+	}
+	num, den := new(big.Int), new(big.Int)
+	var prod, w big.Int
+	for i, v := range values {
+		if weights[i] < 0 {
+			return 0, errWeights
+		}
+		w.SetInt64(weights[i])
+		num.Add(num, prod.Mul(prod.SetInt64(v), &w))
+		den.Add(den, &w)
+	}
+	if den.Sign() == 0 {
+		return 0, errWeights
+	}
+	q, r := new(big.Int).QuoRem(num, den, new(big.Int))
+	// Round half to even by comparing 2|r| with den; QuoRem truncates toward zero.
+	r.Abs(r).Lsh(r, 1)
+	if c := r.Cmp(den); c > 0 || (c == 0 && q.Bit(0) != 0) {
+		if num.Sign() < 0 {
+			q.Sub(q, big.NewInt(1))
+		} else {
+			q.Add(q, big.NewInt(1))
+		}
+	}
+	if !q.IsInt64() {
+		return 0, errOverflow
+	}
+	return q.Int64(), nil
+}
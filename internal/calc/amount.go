@@ -2,6 +2,7 @@ package calc
 
 import (
 	"fmt"
+	"math"
 
 	"github.com/govalues/decimal"
 )
@@ -49,6 +50,12 @@ func Sub(a, b int64, scale int32) (int64, error) {
 // AddPercent applies an integer percent increase to a minor-unit amount.
 // Example: AddPercent(10000, 10, 2) -> 11000.
 func AddPercent(value, percent int64, scale int32) (int64, error) {
+	return AddPercentMode(value, percent, scale, HalfEven)
+}
+
+// AddPercentMode applies an integer percent increase and rounds with the given mode.
+// Example: AddPercentMode(1015, 10, 2, HalfUp) -> 1117.
+func AddPercentMode(value, percent int64, scale int32, mode Mode) (int64, error) {
 	da, err := newAmount(value, scale)
 	if err != nil {
 		return 0, err
@@ -57,12 +64,18 @@ func AddPercent(value, percent int64, scale int32) (int64, error) {
 	if err != nil {
 		return 0, err
 	}
-	return Round(out.dec, scale)
+	return RoundMode(out.dec, scale, mode)
 }
 
 // SubtractPercent applies an integer percent decrease to a minor-unit amount.
 // Example: SubtractPercent(10000, 10, 2) -> 9000.
 func SubtractPercent(value, percent int64, scale int32) (int64, error) {
+	return SubtractPercentMode(value, percent, scale, HalfEven)
+}
+
+// SubtractPercentMode applies an integer percent decrease and rounds with the given mode.
+// Example: SubtractPercentMode(1015, 10, 2, TowardZero) -> 913.
+func SubtractPercentMode(value, percent int64, scale int32, mode Mode) (int64, error) {
 	da, err := newAmount(value, scale)
 	if err != nil {
 		return 0, err
@@ -71,7 +84,77 @@ func SubtractPercent(value, percent int64, scale int32) (int64, error) {
 	if err != nil {
 		return 0, err
 	}
-	return Round(out.dec, scale)
+	return RoundMode(out.dec, scale, mode)
+}
+
+// GrowPercent compounds an integer percent over periods and rounds once at the end.
+// Example: GrowPercent(100000, 10, 3, 2) -> 133100.
+func GrowPercent(value, percent int64, periods int, scale int32) (int64, error) {
+	if periods < 0 {
+		return 0, fmt.Errorf("negative periods")
+	}
+	da, err := newAmount(value, scale)
+	if err != nil {
+		return 0, err
+	}
+	mult, err := percentMultiplier(percent, true)
+	if err != nil {
+		return 0, err
+	}
+	growth, err := mult.PowInt(periods)
+	if err != nil {
+		return 0, err
+	}
+	out, err := da.dec.Mul(growth)
+	if err != nil {
+		return 0, err
+	}
+	return Round(out, scale)
+}
+
+// Rescale converts minor units from one scale to another, rounding when the
+// target scale is smaller.
+// Example: Rescale(123, 0, 2) -> 12300; Rescale(1055, 2, 1) -> 106.
+func Rescale(value int64, from, to int32) (int64, error) {
+	if to < 0 {
+		return 0, errOverflow
+	}
+	da, err := newAmount(value, from)
+	if err != nil {
+		return 0, err
+	}
+	return Round(da.dec, to)
+}
+
+// RescaleMode is Rescale with an explicit rounding mode for down-scaling.
+// Example: RescaleMode(105050, 4, 2, HalfUp) -> 1051.
+func RescaleMode(value int64, from, to int32, mode Mode) (int64, error) {
+	if to < 0 {
+		return 0, errOverflow
+	}
+	da, err := newAmount(value, from)
+	if err != nil {
+		return 0, err
+	}
+	return RoundMode(da.dec, to, mode)
+}
+
+// Convert multiplies a minor-unit amount by a scaled rate and rounds to the target scale.
+// Example: Convert(10000, 2, 79, 2, 2) -> 7900.
+func Convert(value int64, fromScale int32, rate int64, rateScale int32, toScale int32) (int64, error) {
+	da, err := newAmount(value, fromScale)
+	if err != nil {
+		return 0, err
+	}
+	mult, err := decimal.New(rate, int(rateScale))
+	if err != nil {
+		return 0, err
+	}
+	out, err := da.multiply(mult)
+	if err != nil {
+		return 0, err
+	}
+	return Round(out.dec, toScale)
 }
 
 // Compare compares two minor-unit amounts using the given scale.
@@ -88,9 +171,37 @@ func Compare(a, b int64, scale int32) (int, error) {
 	return da.dec.Cmp(db.dec), nil
 }
 
+// CompareScaled compares two minor-unit amounts that may use different scales.
+// Example: CompareScaled(1050, 2, 10500, 3) -> 0.
+func CompareScaled(a int64, aScale int32, b int64, bScale int32) (int, error) {
+	da, err := newAmount(a, aScale)
+	if err != nil {
+		return 0, err
+	}
+	db, err := newAmount(b, bScale)
+	if err != nil {
+		return 0, err
+	}
+	return da.dec.Cmp(db.dec), nil
+}
+
 // Mul multiplies a minor-unit amount by an integer factor.
+// The decimal intermediate holds any int64 product exactly, so the result is
+// either the exact product or an overflow error; it never wraps. Products that
+// fit in int64 skip the decimal path entirely.
 // Example: Mul(1000, 2, 2) -> 2000.
 func Mul(value, factor int64, scale int32) (int64, error) {
+	if scale >= 0 && scale < decimal.MaxScale {
+		if prod, ok := mulInt64(value, factor); ok {
+			return prod, nil
+		}
+	}
+	return mulDecimal(value, factor, scale)
+}
+
+// mulDecimal is the decimal path of Mul, used when the int64 product overflows.
+// Example: mulDecimal(1000, 2, 2) -> 2000.
+func mulDecimal(value, factor int64, scale int32) (int64, error) {
 	da, err := newAmount(value, scale)
 	if err != nil {
 		return 0, err
@@ -124,6 +235,41 @@ func Div(value, divisor int64, scale int32) (int64, error) {
 	return Round(out.dec, scale)
 }
 
+// DivMode divides a minor-unit amount by an integer divisor, rounding the exact
+// quotient with the given mode.
+// Example: DivMode(1001, 2, HalfUp) -> 501.
+func DivMode(value, divisor int64, mode Mode) (int64, error) {
+	if divisor == 0 {
+		return 0, fmt.Errorf("division by zero")
+	}
+	if value == math.MinInt64 && divisor == -1 {
+		return 0, errOverflow
+	}
+	return roundQuotient(value/divisor, value%divisor, divisor, mode)
+}
+
+// Quo divides a minor-unit amount by an integer divisor without rounding to the
+// scale, returning the quotient in major units at the decimal's full precision.
+// Example: Quo(1000, 3, 2) -> "3.333333333333333333".
+func Quo(value, divisor int64, scale int32) (string, error) {
+	if divisor == 0 {
+		return "", fmt.Errorf("division by zero")
+	}
+	da, err := newAmount(value, scale)
+	if err != nil {
+		return "", err
+	}
+	div, err := decimal.New(divisor, 0)
+	if err != nil {
+		return "", err
+	}
+	out, err := da.divide(div, scale)
+	if err != nil {
+		return "", err
+	}
+	return out.dec.String(), nil
+}
+
 // newAmount wraps minor units into a decimal with the provided scale.
 // Example: newAmount(1050, 2) -> 10.50.
 func newAmount(value int64, scale int32) (amount, error) {
@@ -0,0 +1,54 @@
+package calc
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestMulFastPathParity(t *testing.T) {
+	boundary := []int64{0, 1, -1, 2, -2, 10, 99, 3037000499, -3037000500, math.MaxInt32, math.MinInt32, math.MaxInt64, math.MinInt64, math.MaxInt64 / 2, math.MinInt64 / 2}
+	check := func(value, factor int64, scale int32) {
+		t.Helper()
+		got, gotErr := Mul(value, factor, scale)
+		want, wantErr := mulDecimal(value, factor, scale)
+		if (gotErr != nil) != (wantErr != nil) || got != want {
+			t.Fatalf("Mul(%d, %d, %d) = %d, %v; decimal path = %d, %v", value, factor, scale, got, gotErr, want, wantErr)
+		}
+	}
+
+	for _, scale := range []int32{0, 2, 3, 18, 19, 20, -1} {
+		for _, v := range boundary {
+			for _, f := range boundary {
+				check(v, f, scale)
+			}
+		}
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 20000; i++ {
+		// Mix full-range values with small quantities and prices.
+		v, f := int64(r.Uint64()), int64(r.Uint64())
+		if i%2 == 0 {
+			v >>= r.Intn(64)
+			f >>= r.Intn(64)
+		}
+		check(v, f, int32(r.Intn(19)))
+	}
+}
+
+func BenchmarkMul(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, err := Mul(1999, int64(i%100+1), 2); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkMulDecimal(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, err := mulDecimal(1999, int64(i%100+1), 2); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
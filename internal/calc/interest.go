@@ -0,0 +1,107 @@
+package calc
+
+import (
+	"errors"
+	"math"
+	"math/bits"
+)
+
+var (
+	errInterest = errors.New("invalid interest parameters")
+	errMulDiv   = errors.New("invalid mul-div operands")
+)
+
+// SimpleInterest returns value * rateBps/10000 * days/basis in the same minor
+// units, computed exactly in 128 bits and rounded half to even.
+// Example: SimpleInterest(100000000, 500, 90, 360) -> 1250000.
+func SimpleInterest(value, rateBps, days, basis int64) (int64, error) {
+	if rateBps < 0 || days < 0 || basis <= 0 {
+		return 0, errInterest
+	}
+	factor, ok := mulInt64(rateBps, days)
+	if !ok {
+		return 0, errOverflow
+	}
+	divisor, ok := mulInt64(10000, basis)
+	if !ok {
+		return 0, errOverflow
+	}
+	return MulDiv(value, factor, divisor)
+}
+
+// MulDiv returns value * num / den computed exactly in 128 bits and rounded
+// half to even. num must be non-negative and den positive.
+// Example: MulDiv(1190, 10000, 11900) -> 1000.
+func MulDiv(value, num, den int64) (int64, error) {
+	if num < 0 || den <= 0 {
+		return 0, errMulDiv
+	}
+	return mulDiv(absInt64(value), uint64(num), uint64(den), value < 0)
+}
+
+// DivToScale divides a minor-unit amount at scale from by divisor and returns
+// the quotient in minor units of the larger scale to, rounded half to even.
+// Example: DivToScale(1000, 3, 2, 4) -> 33333.
+func DivToScale(value, divisor int64, from, to int32) (int64, error) {
+	if divisor == 0 || from < 0 || to < from {
+		return 0, errMulDiv
+	}
+	pow, ok := pow10Int64(to - from)
+	if !ok {
+		return 0, errOverflow
+	}
+	return mulDiv(absInt64(value), uint64(pow), absInt64(divisor), (value < 0) != (divisor < 0))
+}
+
+// ConvertInverse divides a minor-unit amount by a scaled rate and returns minor
+// units at toScale, computed exactly in 128 bits and rounded half to even.
+// Example: ConvertInverse(7900, 2, 79, 2, 2) -> 10000.
+func ConvertInverse(value int64, fromScale int32, rate int64, rateScale, toScale int32) (int64, error) {
+	if rate <= 0 || fromScale < 0 || rateScale < 0 || toScale < 0 {
+		return 0, errMulDiv
+	}
+	// value/10^fromScale / (rate/10^rateScale) * 10^toScale
+	exp := rateScale + toScale - fromScale
+	if exp >= 0 {
+		pow, ok := pow10Int64(exp)
+		if !ok {
+			return 0, errOverflow
+		}
+		return mulDiv(absInt64(value), uint64(pow), uint64(rate), value < 0)
+	}
+	den := uint64(rate)
+	for ; exp < 0; exp++ {
+		hi, lo := bits.Mul64(den, 10)
+		if hi != 0 {
+			// A divisor of 2^64 or more leaves at most half a minor unit,
+			// which rounds to zero.
+			return 0, nil
+		}
+		den = lo
+	}
+	return mulDiv(absInt64(value), 1, den, value < 0)
+}
+
+// mulDiv returns ±a * num / den rounded half to even, failing outside int64.
+// Example: mulDiv(1000, 100, 3, true) -> -33333.
+func mulDiv(a, num, den uint64, negative bool) (int64, error) {
+	hi, lo := bits.Mul64(a, num)
+	if hi >= den {
+		return 0, errOverflow
+	}
+	q, r := bits.Div64(hi, lo, den)
+	if half := den - r; r > half || (r == half && q%2 != 0) {
+		q++
+	}
+	if negative {
+		if q > uint64(math.MaxInt64)+1 {
+			return 0, errOverflow
+		}
+		// q <= 2^63, so negation also covers math.MinInt64.
+		return -int64(q), nil
+	}
+	if q > math.MaxInt64 {
+		return 0, errOverflow
+	}
+	return int64(q), nil
+}
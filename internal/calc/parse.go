@@ -0,0 +1,71 @@
+package calc
+
+import (
+	"errors"
+
+	"github.com/govalues/decimal"
+)
+
+var (
+	errSyntax    = errors.New("invalid decimal syntax")
+	errPrecision = errors.New("too many fractional digits")
+)
+
+// Parse converts a plain decimal string to minor units using the given scale.
+// The text must match [+-]digits[.digits]; fractional digits beyond scale are rejected.
+// Example: Parse("10.5", 2) -> 1050.
+func Parse(text string, scale int32) (int64, error) {
+	if !isPlainDecimal(text) {
+		return 0, errSyntax
+	}
+	d, err := decimal.Parse(text)
+	if err != nil {
+		return 0, err
+	}
+	if d.Scale() > int(scale) {
+		return 0, errPrecision
+	}
+	return Round(d, scale)
+}
+
+// ParseRound is Parse but rounds extra fractional digits half to even instead
+// of rejecting them.
+// Example: ParseRound("10.505", 2) -> 1050.
+func ParseRound(text string, scale int32) (int64, error) {
+	if !isPlainDecimal(text) {
+		return 0, errSyntax
+	}
+	d, err := decimal.Parse(text)
+	if err != nil {
+		return 0, err
+	}
+	return Round(d, scale)
+}
+
+// isPlainDecimal reports whether text is an optionally signed decimal without exponent.
+// Example: isPlainDecimal("-10.50") -> true, isPlainDecimal("1e3") -> false.
+func isPlainDecimal(text string) bool {
+	i := 0
+	if i < len(text) && (text[i] == '+' || text[i] == '-') {
+		i++
+	}
+	start := i
+	for i < len(text) && text[i] >= '0' && text[i] <= '9' {
+		i++
+	}
+	if i == start {
+		return false
+	}
+	if i == len(text) {
+		return true
+	}
+	if text[i] != '.' {
+		return false
+	}
+	i++
+	start = i
+	for i < len(text) && text[i] >= '0' && text[i] <= '9' {
+		i++
+	}
+	return i > start && i == len(text)
+}
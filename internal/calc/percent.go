@@ -13,3 +13,21 @@ func percentMultiplier(percent int64, add bool) (decimal.Decimal, error) {
 	}
 	return decimal.New(base, 2)
 }
+
+// PercentOf returns percent% of a minor-unit amount, rounded half to even.
+// Example: PercentOf(20000, 10, 2) -> 2000.
+func PercentOf(value, percent int64, scale int32) (int64, error) {
+	da, err := newAmount(value, scale)
+	if err != nil {
+		return 0, err
+	}
+	mult, err := decimal.New(percent, 2)
+	if err != nil {
+		return 0, err
+	}
+	out, err := da.multiply(mult)
+	if err != nil {
+		return 0, err
+	}
+	return Round(out.dec, scale)
+}
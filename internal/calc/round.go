@@ -16,6 +16,7 @@ func Round(d decimal.Decimal, scale int32) (int64, error) {
 }
 
 // roundToMinor rounds a decimal to minor units using the scale.
+// A tiny negative value rounds to a signed decimal zero, which becomes a plain 0 here.
 // Example: roundToMinor(12.345, 2) -> 1235.
 func roundToMinor(d decimal.Decimal, scale int32) (int64, error) {
 	rounded := d.Round(int(scale))
@@ -50,6 +51,12 @@ func combineInt64(whole, frac int64, scale int32) (int64, error) {
 	return res, nil
 }
 
+// Pow10 returns 10^scale if it fits in int64.
+// Example: Pow10(3) -> 1000, true.
+func Pow10(scale int32) (int64, bool) {
+	return pow10Int64(scale)
+}
+
 // pow10Int64 returns 10^scale if it fits in int64.
 // Example: pow10Int64(2) -> 100, true.
 func pow10Int64(scale int32) (int64, bool) {
@@ -123,3 +130,139 @@ func absInt64(x int64) uint64 {
 	}
 	return uint64(-x)
 }
+
+// Mode selects how a value is rounded to the target scale.
+type Mode int32
+
+const (
+	// HalfEven rounds to nearest, ties to even (banker's rounding).
+	HalfEven Mode = iota
+	// HalfUp rounds to nearest, ties toward positive infinity.
+	HalfUp
+	// TowardZero truncates.
+	TowardZero
+	// Floor rounds toward negative infinity.
+	Floor
+	// Ceiling rounds toward positive infinity.
+	Ceiling
+	// HalfAwayFromZero rounds to nearest, ties away from zero.
+	HalfAwayFromZero
+)
+
+var errMode = errors.New("unknown rounding mode")
+
+// RoundMode converts a decimal to minor units using the target scale and mode.
+// Example: RoundMode(decimal.New(-10015, 3), 2, HalfUp) -> -1001.
+func RoundMode(d decimal.Decimal, scale int32, mode Mode) (int64, error) {
+	if scale < 0 {
+		return 0, errOverflow
+	}
+	var rounded decimal.Decimal
+	switch mode {
+	case HalfEven:
+		rounded = d.Round(int(scale))
+	case TowardZero:
+		rounded = d.Trunc(int(scale))
+	case Floor:
+		rounded = d.Floor(int(scale))
+	case Ceiling:
+		rounded = d.Ceil(int(scale))
+	case HalfUp:
+		var err error
+		rounded, err = roundHalfUp(d, scale)
+		if err != nil {
+			return 0, err
+		}
+	case HalfAwayFromZero:
+		var err error
+		rounded, err = roundHalfUp(d.Abs(), scale)
+		if err != nil {
+			return 0, err
+		}
+		rounded = rounded.CopySign(d)
+	default:
+		return 0, errMode
+	}
+	return roundToMinor(rounded, scale)
+}
+
+// roundHalfUp rounds to nearest with ties toward positive infinity.
+// Example: roundHalfUp(-10.015, 2) -> -10.01.
+func roundHalfUp(d decimal.Decimal, scale int32) (decimal.Decimal, error) {
+	if d.Scale() <= int(scale) {
+		return d, nil
+	}
+	floor := d.Floor(int(scale))
+	rem, err := d.Sub(floor)
+	if err != nil {
+		return decimal.Decimal{}, err
+	}
+	half, err := decimal.New(5, int(scale)+1)
+	if err != nil {
+		return decimal.Decimal{}, err
+	}
+	if rem.Cmp(half) < 0 {
+		return floor, nil
+	}
+	ulp, err := decimal.New(1, int(scale))
+	if err != nil {
+		return decimal.Decimal{}, err
+	}
+	return floor.Add(ulp)
+}
+
+// roundQuotient rounds the integer quotient q of a division with remainder r.
+// Example: roundQuotient(500, 1, 2, HalfUp) -> 501.
+func roundQuotient(q, r, divisor int64, mode Mode) (int64, error) {
+	if r == 0 {
+		return q, nil
+	}
+	negative := (r < 0) != (divisor < 0)
+	absR, absD := absInt64(r), absInt64(divisor)
+	var away bool
+	switch mode {
+	case HalfEven:
+		away = absR > absD-absR || (absR == absD-absR && q%2 != 0)
+	case HalfUp:
+		away = absR > absD-absR || (absR == absD-absR && !negative)
+	case HalfAwayFromZero:
+		away = absR >= absD-absR
+	case TowardZero:
+		away = false
+	case Floor:
+		away = negative
+	case Ceiling:
+		away = !negative
+	default:
+		return 0, errMode
+	}
+	if !away {
+		return q, nil
+	}
+	step := int64(1)
+	if negative {
+		step = -1
+	}
+	out, ok := addInt64(q, step)
+	if !ok {
+		return 0, errOverflow
+	}
+	return out, nil
+}
+
+// RoundToIncrement rounds value to the nearest multiple of increment with the given mode.
+// Example: RoundToIncrement(1023, 5, HalfEven) -> 1025.
+func RoundToIncrement(value, increment int64, mode Mode) (int64, error) {
+	if increment <= 0 {
+		return 0, errOverflow
+	}
+	q, err := roundQuotient(value/increment, value%increment, increment, mode)
+	if err != nil {
+		return 0, err
+	}
+	out, ok := mulInt64(q, increment)
+	if !ok {
+		return 0, errOverflow
+	}
+	return out, nil
+}
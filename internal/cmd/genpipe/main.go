@@ -135,6 +135,10 @@ func renderParams(fset *token.FileSet, params *ast.FieldList) (string, string) {
 			argBuf.WriteString(name)
 			continue
 		}
+		variadic := ""
+		if _, ok := field.Type.(*ast.Ellipsis); ok {
+			variadic = "..."
+		}
 		for j, name := range nameList {
 			if i > 0 || j > 0 {
 				paramBuf.WriteString(", ")
@@ -146,6 +150,7 @@ func renderParams(fset *token.FileSet, params *ast.FieldList) (string, string) {
 				argBuf.WriteString(", ")
 			}
 			argBuf.WriteString(name.Name)
+			argBuf.WriteString(variadic)
 		}
 	}
 	return paramBuf.String(), argBuf.String()
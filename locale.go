@@ -0,0 +1,49 @@
+package money
+
+import "strings"
+
+var localeFormats = map[string]FormatConfig{
+	"en-US": {DecimalSeparator: ".", ThousandsSeparator: ",", SymbolPosition: SymbolPrefix, SymbolKind: SymbolUseCurrencySymbol},
+	"en-GB": {DecimalSeparator: ".", ThousandsSeparator: ",", SymbolPosition: SymbolPrefix, SymbolKind: SymbolUseCurrencySymbol},
+	"de-DE": {DecimalSeparator: ",", ThousandsSeparator: ".", SymbolPosition: SymbolSuffix, SymbolKind: SymbolUseCurrencySymbol, Space: true},
+	"fr-FR": {DecimalSeparator: ",", ThousandsSeparator: " ", SymbolPosition: SymbolSuffix, SymbolKind: SymbolUseCurrencySymbol, Space: true},
+	"tr-TR": {DecimalSeparator: ",", ThousandsSeparator: ".", SymbolPosition: SymbolPrefix, SymbolKind: SymbolUseCurrencySymbol},
+	"ja-JP": {DecimalSeparator: ".", ThousandsSeparator: ",", SymbolPosition: SymbolPrefix, SymbolKind: SymbolUseCurrencySymbol},
+}
+
+// LocaleFormat returns the FormatConfig preset for a BCP 47 locale tag.
+// Example: LocaleFormat("de-DE") -> FormatConfig{DecimalSeparator:",", ThousandsSeparator:"."...}.
+func LocaleFormat(locale string) (FormatConfig, error) {
+	cfg, ok := localeFormats[strings.ReplaceAll(locale, "_", "-")]
+	if !ok {
+		return FormatConfig{}, ErrInvalidOperation
+	}
+	return cfg, nil
+}
+
+// FormatLocale renders Money using the preset of the given locale.
+// Example: New(123456, EUR).FormatLocale("de-DE") -> "1.234,56 €".
+func (m Money) FormatLocale(locale string) (string, error) {
+	cfg, err := LocaleFormat(locale)
+	if err != nil {
+		return "", err
+	}
+	return m.Format(cfg)
+}
+
+// USDFormat returns the en-US preset: "$1,234.56".
+// Each call returns an independent copy.
+func USDFormat() FormatConfig {
+	return localeFormats["en-US"]
+}
+
+// EUFormat returns the de-DE preset common across the euro area: "1.234,56 €".
+func EUFormat() FormatConfig {
+	return localeFormats["de-DE"]
+}
+
+// CodeSuffixFormat returns a locale-neutral preset with the currency code after
+// the amount and no grouping: "1234.56 USD".
+func CodeSuffixFormat() FormatConfig {
+	return FormatConfig{DecimalSeparator: ".", SymbolPosition: SymbolSuffix, SymbolKind: SymbolUseCurrencyCode, Space: true}
+}
@@ -0,0 +1,71 @@
+package money
+
+import "testing"
+
+func TestFormatLocale(t *testing.T) {
+	eur := Currency{Code: "EUR", Scale: 2, Symbol: "€"}
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+	jpy := Currency{Code: "JPY", Scale: 0, Symbol: "¥"}
+
+	tests := []struct {
+		m      Money
+		locale string
+		want   string
+	}{
+		{New(123456, eur), "de-DE", "1.234,56 €"},
+		{New(123456, eur), "fr-FR", "1 234,56 €"},
+		{New(-123456, usd), "en-US", "-$1,234.56"},
+		{New(1234567, jpy), "ja-JP", "¥1,234,567"},
+		{New(123456, eur), "de_DE", "1.234,56 €"},
+	}
+	for _, tt := range tests {
+		got, err := tt.m.FormatLocale(tt.locale)
+		if err != nil {
+			t.Fatalf("format %s: %v", tt.locale, err)
+		}
+		if got != tt.want {
+			t.Fatalf("format %s = %q, want %q", tt.locale, got, tt.want)
+		}
+	}
+}
+
+func TestFormatLocaleUnknown(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+	if _, err := New(100, usd).FormatLocale("xx-XX"); err != ErrInvalidOperation {
+		t.Fatalf("expected ErrInvalidOperation, got %v", err)
+	}
+}
+
+func TestFormatPresets(t *testing.T) {
+	eur := Currency{Code: "EUR", Scale: 2, Symbol: "€"}
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+
+	tests := []struct {
+		name string
+		cfg  FormatConfig
+		m    Money
+		want string
+	}{
+		{"USDFormat", USDFormat(), New(-123456, usd), "-$1,234.56"},
+		{"EUFormat", EUFormat(), New(123456, eur), "1.234,56 €"},
+		{"CodeSuffixFormat", CodeSuffixFormat(), New(123456, usd), "1234.56 USD"},
+	}
+	for _, tt := range tests {
+		if err := validateFormat(tt.cfg); err != nil {
+			t.Fatalf("%s: invalid preset: %v", tt.name, err)
+		}
+		got, err := tt.m.Format(tt.cfg)
+		if err != nil {
+			t.Fatalf("%s: format: %v", tt.name, err)
+		}
+		if got != tt.want {
+			t.Fatalf("%s = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+
+	cfg := USDFormat()
+	cfg.DecimalSeparator = ","
+	if USDFormat().DecimalSeparator != "." {
+		t.Fatalf("USDFormat returned shared state")
+	}
+}
@@ -1,7 +1,9 @@
 package money
 
 import (
+	"fmt"
 	"math"
+	"math/bits"
 	"strconv"
 
 	"github.com/Opvra/go-money/internal/calc"
@@ -20,6 +22,8 @@ func Zero(currency Currency) Money {
 }
 
 // Money represents a currency-aware monetary amount in minor units.
+// Money is an immutable value: every operation returns a new Money and never
+// modifies its receiver, so values may be copied and shared freely.
 // Example: New(1050, USD) represents $10.50.
 type Money struct {
 	amount   int64
@@ -32,12 +36,83 @@ func (m Money) Amount() int64 {
 	return m.amount
 }
 
+// AmountAtScale returns the amount in minor units of the given scale.
+// Down-scaling rounds half to even; results that do not fit in int64 return
+// ErrInvalidOperation.
+// Example: New(123, JPY).AmountAtScale(2) -> 12300.
+func (m Money) AmountAtScale(scale int32) (int64, error) {
+	amount, err := calc.Rescale(m.amount, m.currency.Scale, scale)
+	if err != nil {
+		return 0, ErrInvalidOperation
+	}
+	return amount, nil
+}
+
+// MigrateScale converts raw minor units stored at fromScale to toScale, for
+// migrating persisted data when a currency's scale changes. Up-scaling is
+// exact; down-scaling rounds with mode. Negative scales and results outside
+// int64 return ErrInvalidOperation.
+// Example: MigrateScale(1050, 2, 4, RoundHalfEven) -> 105000.
+func MigrateScale(amount int64, fromScale, toScale int32, mode RoundingMode) (int64, error) {
+	if fromScale < 0 || toScale < 0 {
+		return 0, ErrInvalidOperation
+	}
+	out, err := calc.RescaleMode(amount, fromScale, toScale, calc.Mode(mode))
+	if err != nil {
+		return 0, ErrInvalidOperation
+	}
+	return out, nil
+}
+
+// MinorUnitsAtScale2 returns the amount in hundredths of the major unit, for
+// "give me cents" APIs. Scales below 2 are up-scaled exactly; higher scales
+// round half to even. Results outside int64 saturate at math.MaxInt64 or
+// math.MinInt64 rather than failing. A currency scale outside [0, 18], which
+// Currency.Validate rejects, returns 0.
+// Example: New(1005, BHD).MinorUnitsAtScale2() -> 100.
+func (m Money) MinorUnitsAtScale2() int64 {
+	if m.currency.Scale < 0 || m.currency.Scale > maxCurrencyScale {
+		return 0
+	}
+	// With a valid scale only up-scaling can fail, and only by overflowing.
+	amount, err := m.AmountAtScale(2)
+	if err != nil {
+		if m.amount < 0 {
+			return math.MinInt64
+		}
+		return math.MaxInt64
+	}
+	return amount
+}
+
+// Validate reports whether m can be used and displayed safely, which comes
+// down to its currency passing Currency.Validate: every int64 amount has an
+// exact decimal form at a scale of 0 to 18, so the amount needs no check.
+// Example: New(1050, Currency{Code: "USD", Scale: -2}).Validate() -> error.
+func (m Money) Validate() error {
+	return m.currency.Validate()
+}
+
 // Currency returns the currency of the money.
 // Example: New(1050, USD).Currency().Code -> "USD".
 func (m Money) Currency() Currency {
 	return m.currency
 }
 
+// WithAmount returns a copy of m with a new minor-unit amount and the same currency.
+// Example: New(1050, USD).WithAmount(99).Amount() -> 99.
+func (m Money) WithAmount(amount int64) Money {
+	return Money{amount: amount, currency: m.currency}
+}
+
+// Clone returns an independent copy of the Money value.
+// A plain assignment is equivalent today; Clone stays correct if Money ever
+// gains reference fields.
+// Example: New(1050, USD).Clone().Amount() -> 1050.
+func (m Money) Clone() Money {
+	return Money{amount: m.amount, currency: m.currency}
+}
+
 // Add adds two Money values of the same currency.
 // Example: New(1050, USD).Add(New(250, USD)) -> 1300.
 func (m Money) Add(x Money) (Money, error) {
@@ -74,6 +149,21 @@ func (m Money) AddPercent(percent int64) (Money, error) {
 	return Money{amount: amount, currency: m.currency}, nil
 }
 
+// AddPercentOf adds percent% of base to m, as for a fee tiered on another
+// amount. Both must share a currency; the share of base is rounded half to even
+// before it is added.
+// Example: New(5000, USD).AddPercentOf(10, New(20000, USD)) -> 7000.
+func (m Money) AddPercentOf(percent int64, base Money) (Money, error) {
+	if !sameCurrency(m.currency, base.currency) {
+		return Money{}, ErrCurrencyMismatch
+	}
+	share, err := calc.PercentOf(base.amount, percent, base.currency.Scale)
+	if err != nil {
+		return Money{}, ErrInvalidOperation
+	}
+	return m.Add(Money{amount: share, currency: m.currency})
+}
+
 // SubtractPercent decreases the Money amount by an integer percentage.
 // Example: New(10000, USD).SubtractPercent(10) -> 9000.
 func (m Money) SubtractPercent(percent int64) (Money, error) {
@@ -84,7 +174,164 @@ func (m Money) SubtractPercent(percent int64) (Money, error) {
 	return Money{amount: amount, currency: m.currency}, nil
 }
 
+// AddPercentMode increases the Money amount by an integer percentage using the given rounding mode.
+// Example: New(1015, USD).AddPercentMode(10, RoundHalfUp) -> 1117.
+func (m Money) AddPercentMode(percent int64, mode RoundingMode) (Money, error) {
+	amount, err := calc.AddPercentMode(m.amount, percent, m.currency.Scale, calc.Mode(mode))
+	if err != nil {
+		return Money{}, ErrInvalidOperation
+	}
+	return Money{amount: amount, currency: m.currency}, nil
+}
+
+// SubtractPercentMode decreases the Money amount by an integer percentage using the given rounding mode.
+// Example: New(1015, USD).SubtractPercentMode(10, RoundTowardZero) -> 913.
+func (m Money) SubtractPercentMode(percent int64, mode RoundingMode) (Money, error) {
+	amount, err := calc.SubtractPercentMode(m.amount, percent, m.currency.Scale, calc.Mode(mode))
+	if err != nil {
+		return Money{}, ErrInvalidOperation
+	}
+	return Money{amount: amount, currency: m.currency}, nil
+}
+
+// AddPercentBankers increases the Money amount by an integer percentage with
+// round-half-to-even, as many tax authorities require. It equals the current
+// default but stays pinned to half-even regardless of future defaults.
+// Example: New(1015, USD).AddPercentBankers(10) -> 1116.
+func (m Money) AddPercentBankers(percent int64) (Money, error) {
+	return m.AddPercentMode(percent, RoundHalfEven)
+}
+
+// SubtractPercentBankers decreases the Money amount by an integer percentage with round-half-to-even.
+// Example: New(1015, USD).SubtractPercentBankers(10) -> 914.
+func (m Money) SubtractPercentBankers(percent int64) (Money, error) {
+	return m.SubtractPercentMode(percent, RoundHalfEven)
+}
+
+// SubtractPercentPoints decreases the Money amount by the sum of the given percentages.
+// Points stack additively (10 and 5 mean 15% off, not 10% then 5%) and the total
+// is capped at 100, so the result never crosses zero.
+// Example: New(10000, USD).SubtractPercentPoints(10, 5) -> 8500.
+func (m Money) SubtractPercentPoints(points ...int64) (Money, error) {
+	var total int64
+	for _, p := range points {
+		if (p > 0 && total > math.MaxInt64-p) || (p < 0 && total < math.MinInt64-p) {
+			return Money{}, ErrInvalidOperation
+		}
+		total += p
+	}
+	return m.SubtractPercent(min(total, 100))
+}
+
+// SubtractPercentCompound applies each percentage in turn to the already
+// reduced amount, rounding half to even after every step as a till would.
+// Unlike SubtractPercentPoints, 10 then 5 take 14.5% off rather than 15%.
+// Each percentage is capped at 100, so the result never crosses zero.
+// Example: New(10000, USD).SubtractPercentCompound(10, 5) -> 8550.
+func (m Money) SubtractPercentCompound(percents ...int64) (Money, error) {
+	out := m
+	for _, p := range percents {
+		var err error
+		if out, err = out.SubtractPercent(min(p, 100)); err != nil {
+			return Money{}, err
+		}
+	}
+	return out, nil
+}
+
+// SubtractPercentClamped decreases the Money amount by an integer percentage,
+// treating anything above 100 as 100 so the result stops at zero instead of
+// crossing it. Clamping happens exactly when percent > 100; use
+// SubtractPercentStrict to have that reported as an error instead. A negative
+// percent would raise the amount and returns ErrInvalidOperation.
+// Example: New(1000, USD).SubtractPercentClamped(150) -> 0.
+func (m Money) SubtractPercentClamped(percent int64) (Money, error) {
+	if percent < 0 {
+		return Money{}, ErrInvalidOperation
+	}
+	return m.SubtractPercent(min(percent, 100))
+}
+
+// SubtractPercentStrict decreases the Money amount by an integer percentage,
+// rejecting percentages outside [0, 100] with ErrInvalidOperation.
+// Example: New(1000, USD).SubtractPercentStrict(150) -> ErrInvalidOperation.
+func (m Money) SubtractPercentStrict(percent int64) (Money, error) {
+	if percent < 0 || percent > 100 {
+		return Money{}, ErrInvalidOperation
+	}
+	return m.SubtractPercent(percent)
+}
+
+// GrowByPercent compounds an integer percentage over the given number of periods.
+// With perPeriod set, the amount is rounded to the currency scale after every
+// period, as a ledger would; otherwise growth is computed in decimal and rounded once.
+// A percent of -100 shrinks the amount to zero; below that it would flip the
+// sign, so it returns ErrInvalidOperation, as do negative periods.
+// Example: New(100000, USD).GrowByPercent(10, 3, true) -> 133100.
+func (m Money) GrowByPercent(percent int64, periods int, perPeriod bool) (Money, error) {
+	if periods < 0 || percent < -100 {
+		return Money{}, ErrInvalidOperation
+	}
+	if !perPeriod {
+		amount, err := calc.GrowPercent(m.amount, percent, periods, m.currency.Scale)
+		if err != nil {
+			return Money{}, ErrInvalidOperation
+		}
+		return Money{amount: amount, currency: m.currency}, nil
+	}
+	out := m
+	for i := 0; i < periods; i++ {
+		next, err := out.AddPercent(percent)
+		if err != nil {
+			return Money{}, err
+		}
+		out = next
+	}
+	return out, nil
+}
+
+// Abs returns the absolute value of the Money amount.
+// The most negative int64 amount has no positive counterpart and returns ErrInvalidOperation.
+// Example: New(-1050, USD).Abs() -> 1050.
+func (m Money) Abs() (Money, error) {
+	if m.amount >= 0 {
+		return m, nil
+	}
+	return m.Negate()
+}
+
+// AbsDiff returns |m - x|, requiring matching currencies. It works on the
+// exact difference, so Sub overflow and the math.MinInt64 case of Abs do not
+// apply; only a distance beyond math.MaxInt64 returns ErrInvalidOperation.
+// Example: New(250, USD).AbsDiff(New(1050, USD)) -> 800.
+func (m Money) AbsDiff(x Money) (Money, error) {
+	if !sameCurrency(m.currency, x.currency) {
+		return Money{}, ErrCurrencyMismatch
+	}
+	// The true distance is below 2^64, so modular uint64 subtraction is exact.
+	diff := uint64(m.amount) - uint64(x.amount)
+	if m.amount < x.amount {
+		diff = uint64(x.amount) - uint64(m.amount)
+	}
+	if diff > math.MaxInt64 {
+		return Money{}, ErrInvalidOperation
+	}
+	return Money{amount: int64(diff), currency: m.currency}, nil
+}
+
+// Negate returns the Money amount with the opposite sign.
+// The most negative int64 amount has no positive counterpart and returns ErrInvalidOperation.
+// Example: New(1050, USD).Negate() -> -1050.
+func (m Money) Negate() (Money, error) {
+	if m.amount == math.MinInt64 {
+		return Money{}, ErrInvalidOperation
+	}
+	return Money{amount: -m.amount, currency: m.currency}, nil
+}
+
 // Mul multiplies the Money amount by an integer factor.
+// The product is computed exactly; if it does not fit in int64 minor units,
+// Mul returns ErrInvalidOperation instead of wrapping around.
 // Example: New(1000, USD).Mul(2) -> 2000.
 func (m Money) Mul(factor int64) (Money, error) {
 	amount, err := calc.Mul(m.amount, factor, m.currency.Scale)
@@ -95,8 +342,12 @@ func (m Money) Mul(factor int64) (Money, error) {
 }
 
 // Div divides the Money amount by an integer divisor.
+// A zero divisor returns ErrDivideByZero.
 // Example: New(1000, USD).Div(2) -> 500.
 func (m Money) Div(divisor int64) (Money, error) {
+	if divisor == 0 {
+		return Money{}, ErrDivideByZero
+	}
 	amount, err := calc.Div(m.amount, divisor, m.currency.Scale)
 	if err != nil {
 		return Money{}, ErrInvalidOperation
@@ -104,7 +355,194 @@ func (m Money) Div(divisor int64) (Money, error) {
 	return Money{amount: amount, currency: m.currency}, nil
 }
 
+// DivMode divides the Money amount by an integer divisor, rounding the exact
+// quotient with the given mode. A zero divisor returns ErrDivideByZero.
+// Example: New(1001, USD).DivMode(2, RoundHalfUp) -> 501.
+func (m Money) DivMode(divisor int64, mode RoundingMode) (Money, error) {
+	if divisor == 0 {
+		return Money{}, ErrDivideByZero
+	}
+	amount, err := calc.DivMode(m.amount, divisor, calc.Mode(mode))
+	if err != nil {
+		return Money{}, ErrInvalidOperation
+	}
+	return Money{amount: amount, currency: m.currency}, nil
+}
+
+// DivToScale divides m by an integer divisor and keeps scale fraction digits,
+// for unit prices that need more precision than the currency allows. The
+// result carries a copy of m's currency with Scale set to scale, so it only
+// combines with Money at that same scale. The quotient is rounded half to even.
+// A zero divisor returns ErrDivideByZero; a scale below the currency scale or
+// above 18 returns ErrInvalidOperation.
+// Example: New(1000, USD).DivToScale(3, 4) -> 33333 at scale 4 ($3.3333).
+func (m Money) DivToScale(divisor int64, scale int32) (Money, error) {
+	if divisor == 0 {
+		return Money{}, ErrDivideByZero
+	}
+	if scale < m.currency.Scale || scale > maxCurrencyScale {
+		return Money{}, ErrInvalidOperation
+	}
+	amount, err := calc.DivToScale(m.amount, divisor, m.currency.Scale, scale)
+	if err != nil {
+		return Money{}, ErrInvalidOperation
+	}
+	currency := m.currency
+	currency.Scale = scale
+	return Money{amount: amount, currency: currency}, nil
+}
+
+// DivBankers divides the Money amount by an integer divisor with round-half-to-even.
+// Example: New(1001, USD).DivBankers(2) -> 500.
+func (m Money) DivBankers(divisor int64) (Money, error) {
+	return m.DivMode(divisor, RoundHalfEven)
+}
+
+// DivDecimal divides the Money amount by an integer divisor without rounding to
+// the currency scale and returns the quotient in major units as a decimal
+// string, so callers choose the precision. It is a string rather than a decimal
+// type to keep decimals out of the public API; precision is 19 significant digits.
+// Example: New(1000, USD).DivDecimal(3) -> "3.333333333333333333".
+func (m Money) DivDecimal(divisor int64) (string, error) {
+	if divisor == 0 {
+		return "", ErrDivideByZero
+	}
+	text, err := calc.Quo(m.amount, divisor, m.currency.Scale)
+	if err != nil {
+		return "", ErrInvalidOperation
+	}
+	return text, nil
+}
+
+// RoundAwayFromZero rounds m to toScale decimal places with ties away from
+// zero (commercial rounding), keeping the currency scale. A toScale at or above
+// the currency scale returns m unchanged; a negative toScale returns
+// ErrInvalidOperation.
+// Example: New(-250, USD).RoundAwayFromZero(0) -> -300.
+func (m Money) RoundAwayFromZero(toScale int32) (Money, error) {
+	if toScale < 0 {
+		return Money{}, ErrInvalidOperation
+	}
+	if toScale >= m.currency.Scale {
+		return m, nil
+	}
+	step, ok := calc.Pow10(m.currency.Scale - toScale)
+	if !ok {
+		return Money{}, ErrInvalidOperation
+	}
+	amount, err := calc.RoundToIncrement(m.amount, step, calc.HalfAwayFromZero)
+	if err != nil {
+		return Money{}, ErrInvalidOperation
+	}
+	return Money{amount: amount, currency: m.currency}, nil
+}
+
+// RoundToCashDenomination rounds m half to even to the smallest cash unit of its
+// currency code, as recorded with RegisterCashIncrement. Currencies without a
+// cash increment are returned unchanged.
+// Example: New(1023, CHF).RoundToCashDenomination() -> 1025.
+func (m Money) RoundToCashDenomination() (Money, error) {
+	amount, err := calc.RoundToIncrement(m.amount, CashIncrement(m.currency.Code), calc.HalfEven)
+	if err != nil {
+		return Money{}, ErrInvalidOperation
+	}
+	return Money{amount: amount, currency: m.currency}, nil
+}
+
+// Convert converts the Money into rate.To using the exchange rate.
+// The receiver currency must match rate.From; the result is rounded half to even.
+// Example: New(10000, USD).Convert(ExchangeRate{From:USD, To:GBP, Rate:79, Scale:2}) -> New(7900, GBP).
+func (m Money) Convert(rate ExchangeRate) (Money, error) {
+	if !sameCurrency(m.currency, rate.From) {
+		return Money{}, ErrCurrencyMismatch
+	}
+	if rate.Rate <= 0 || rate.Scale < 0 {
+		return Money{}, ErrInvalidOperation
+	}
+	amount, err := calc.Convert(m.amount, m.currency.Scale, rate.Rate, rate.Scale, rate.To.Scale)
+	if err != nil {
+		return Money{}, ErrInvalidOperation
+	}
+	return Money{amount: amount, currency: rate.To}, nil
+}
+
+// ConvertInverse converts m back across rate: the receiver currency must match
+// rate.To and the result is in rate.From, dividing by the rate exactly rather
+// than multiplying by a rounded reciprocal. The result is rounded half to even.
+// Example: New(7900, GBP).ConvertInverse(ExchangeRate{From:USD, To:GBP, Rate:79, Scale:2}) -> New(10000, USD).
+func (m Money) ConvertInverse(rate ExchangeRate) (Money, error) {
+	if !sameCurrency(m.currency, rate.To) {
+		return Money{}, ErrCurrencyMismatch
+	}
+	if rate.Rate <= 0 || rate.Scale < 0 {
+		return Money{}, ErrInvalidOperation
+	}
+	amount, err := calc.ConvertInverse(m.amount, m.currency.Scale, rate.Rate, rate.Scale, rate.From.Scale)
+	if err != nil {
+		return Money{}, ErrInvalidOperation
+	}
+	return Money{amount: amount, currency: rate.From}, nil
+}
+
+// ConvertChain converts m through each rate in order, such as USD->EUR->TRY.
+// Every rate's From must match the running currency, and the result is in the
+// last rate's To. Each hop is rounded half to even to its target scale, exactly
+// as a sequence of Convert calls would be. With no rates m is returned as is.
+// Example: New(10000, USD).ConvertChain(usdToEUR(0.92), eurToTRY(35.50)) -> New(326600, TRY).
+func (m Money) ConvertChain(rates ...ExchangeRate) (Money, error) {
+	out := m
+	for _, rate := range rates {
+		next, err := out.Convert(rate)
+		if err != nil {
+			return Money{}, err
+		}
+		out = next
+	}
+	return out, nil
+}
+
+// Max returns the larger of m and x, requiring matching currencies.
+// Example: New(500, USD).Max(New(700, USD)) -> 700.
+func (m Money) Max(x Money) (Money, error) {
+	gt, err := x.GreaterThan(m)
+	if err != nil {
+		return Money{}, err
+	}
+	if gt {
+		return x, nil
+	}
+	return m, nil
+}
+
+// Min returns the smaller of m and x, requiring matching currencies.
+// Example: New(500, USD).Min(New(700, USD)) -> 500.
+func (m Money) Min(x Money) (Money, error) {
+	lt, err := x.LessThan(m)
+	if err != nil {
+		return Money{}, err
+	}
+	if lt {
+		return x, nil
+	}
+	return m, nil
+}
+
+// ClampMin returns m raised to lower if it is below it, requiring matching
+// currencies.
+// Example: New(-250, USD).ClampMin(Zero(USD)) -> 0.
+func (m Money) ClampMin(lower Money) (Money, error) {
+	return m.Max(lower)
+}
+
+// ClampMax returns m capped at upper if it is above it, requiring matching
+// currencies.
+// Example: New(1500, USD).ClampMax(New(1000, USD)) -> 1000.
+func (m Money) ClampMax(upper Money) (Money, error) {
+	return m.Min(upper)
+}
+
 // Equal reports whether two Money values are equal and share the same currency.
+// Equal is lenient: a currency mismatch reports false; use EqualStrict to detect it.
 // Example: New(500, USD).Equal(New(500, USD)) -> true.
 func (m Money) Equal(x Money) bool {
 	if !sameCurrency(m.currency, x.currency) {
@@ -117,6 +555,96 @@ func (m Money) Equal(x Money) bool {
 	return cmp == 0
 }
 
+// EqualStrict reports whether two Money values are equal, requiring matching currencies.
+// Unlike Equal, a currency mismatch returns ErrCurrencyMismatch instead of false.
+// Example: New(500, USD).EqualStrict(New(500, EUR)) -> false, ErrCurrencyMismatch.
+func (m Money) EqualStrict(x Money) (bool, error) {
+	if !sameCurrency(m.currency, x.currency) {
+		return false, ErrCurrencyMismatch
+	}
+	cmp, err := calc.Compare(m.amount, x.amount, m.currency.Scale)
+	if err != nil {
+		return false, ErrInvalidOperation
+	}
+	return cmp == 0, nil
+}
+
+// EqualValue reports whether two Money values with the same currency code
+// represent the same logical value, even when their scales differ.
+// It is value equality, not representation equality; symbols are ignored.
+// Example: New(1050, USD2).EqualValue(New(10500, USD3)) -> true.
+func (m Money) EqualValue(x Money) (bool, error) {
+	if m.currency.Code != x.currency.Code {
+		return false, ErrCurrencyMismatch
+	}
+	cmp, err := calc.CompareScaled(m.amount, m.currency.Scale, x.amount, x.currency.Scale)
+	if err != nil {
+		return false, ErrInvalidOperation
+	}
+	return cmp == 0, nil
+}
+
+// EqualWithinPercent reports whether |m-x| is at most percent% of |x|,
+// requiring matching currencies. The comparison is exact; when x is zero only
+// a zero m matches. A negative percent returns ErrInvalidOperation.
+// Example: New(10100, USD).EqualWithinPercent(New(10000, USD), 1) -> true.
+func (m Money) EqualWithinPercent(x Money, percent int64) (bool, error) {
+	if !sameCurrency(m.currency, x.currency) {
+		return false, ErrCurrencyMismatch
+	}
+	if percent < 0 {
+		return false, ErrInvalidOperation
+	}
+	// The true difference is below 2^64, so modular uint64 subtraction is exact.
+	diff := uint64(m.amount) - uint64(x.amount)
+	if m.amount < x.amount {
+		diff = uint64(x.amount) - uint64(m.amount)
+	}
+	diffHi, diffLo := bits.Mul64(diff, 100)
+	bandHi, bandLo := bits.Mul64(uint64(percent), absUint64(x.amount))
+	return diffHi < bandHi || (diffHi == bandHi && diffLo <= bandLo), nil
+}
+
+// EqualPtr reports whether a and b are both nil or both non-nil and Equal.
+// A nil pointer is a distinct "no amount" value, so it never equals a zero Money.
+// Example: EqualPtr(nil, &zeroUSD) -> false.
+func EqualPtr(a, b *Money) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
+
+// IsNilOrZero reports whether m is nil or holds a zero amount in any currency.
+// Example: IsNilOrZero(nil) -> true.
+func IsNilOrZero(m *Money) bool {
+	return m == nil || m.IsZero()
+}
+
+// EqualForTest reports whether a and b have the same code, scale and amount,
+// ignoring the display symbol. It suits test assertions, including go-cmp via
+// cmp.Comparer(money.EqualForTest), where reflect.DeepEqual is too strict.
+// Example: EqualForTest(New(100, Currency{Code:"USD", Scale:2, Symbol:"$"}), New(100, Currency{Code:"USD", Scale:2})) -> true.
+func EqualForTest(a, b Money) bool {
+	return a.amount == b.amount && a.currency.Code == b.currency.Code && a.currency.Scale == b.currency.Scale
+}
+
+// Key is a comparable identity for Money, for use as a map key. It leaves out
+// the currency symbol, which is display data: "$" and "US$" amounts of the
+// same USD value should land in the same bucket, and Money itself would not.
+// Example: New(500, USD).AsKey() -> Key{Code: "USD", Scale: 2, Amount: 500}.
+type Key struct {
+	Code   string
+	Scale  int32
+	Amount int64
+}
+
+// AsKey returns the Key of m; keys are equal exactly when EqualForTest is true.
+// Example: counts[m.AsKey()]++.
+func (m Money) AsKey() Key {
+	return Key{Code: m.currency.Code, Scale: m.currency.Scale, Amount: m.amount}
+}
+
 // GreaterThan reports whether m is greater than x, requiring matching currencies.
 // Example: New(700, USD).GreaterThan(New(500, USD)) -> true.
 func (m Money) GreaterThan(x Money) (bool, error) {
@@ -161,16 +689,70 @@ func (m Money) IsNegative() bool {
 	return m.amount < 0
 }
 
+// EqualInt reports whether the amount equals minor, a raw amount in minor units
+// of m's currency.
+// Example: New(1050, USD).EqualInt(1050) -> true.
+func (m Money) EqualInt(minor int64) bool {
+	return m.amount == minor
+}
+
+// CompareInt compares the amount with minor, a raw amount in minor units of
+// m's currency, returning -1, 0 or +1.
+// Example: New(1050, USD).CompareInt(2000) -> -1.
+func (m Money) CompareInt(minor int64) int {
+	switch {
+	case m.amount < minor:
+		return -1
+	case m.amount > minor:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Normalized returns m with a canonical zero.
+// Amounts are int64 minor units, so a negative result that rounds to zero is
+// always stored as +0; Normalized makes that guarantee explicit at call sites.
+// Example: New(-4, USD).Div(10) -> 0, and Normalized().String() -> "$0.00".
+func (m Money) Normalized() Money {
+	if m.amount == 0 {
+		return Money{amount: 0, currency: m.currency}
+	}
+	return m
+}
+
+// IsWholeUnit reports whether the amount has no fractional minor units.
+// Scale-0 currencies are always whole.
+// Example: New(1000, USD).IsWholeUnit() -> true; New(1050, USD).IsWholeUnit() -> false.
+func (m Money) IsWholeUnit() bool {
+	if m.currency.Scale <= 0 {
+		return true
+	}
+	unit, ok := calc.Pow10(m.currency.Scale)
+	if !ok {
+		return m.amount == 0
+	}
+	return m.amount%unit == 0
+}
+
 // String returns a human-readable string with the configured formatting.
+// If formatting fails it returns a diagnostic form rather than "", so broken
+// values stay visible in logs.
 // Example (default): New(1050, USD).String() -> "$10.50".
+// Example (failure): "<invalid money: USD scale=40 amount=1050>".
 func (m Money) String() string {
 	text, err := formatWithConfig(m, DefaultFormat())
 	if err != nil {
-		return ""
+		return m.invalidString()
 	}
 	return text
 }
 
+// invalidString is the diagnostic form String and TabString fall back to.
+func (m Money) invalidString() string {
+	return fmt.Sprintf("<invalid money: %s scale=%d amount=%d>", m.currency.Code, m.currency.Scale, m.amount)
+}
+
 func sameCurrency(a, b Currency) bool {
 	return a.Code == b.Code && a.Scale == b.Scale && a.Symbol == b.Symbol
 }
@@ -183,11 +765,17 @@ func signPrefix(amount int64) string {
 }
 
 func absInt64String(amount int64) string {
-	if amount >= 0 {
-		return strconv.FormatInt(amount, 10)
-	}
+	return strconv.FormatUint(absUint64(amount), 10)
+}
+
+// absUint64 returns |amount|, which always fits in uint64.
+// Example: absUint64(math.MinInt64) -> 9223372036854775808.
+func absUint64(amount int64) uint64 {
 	if amount == math.MinInt64 {
-		return strconv.FormatUint(uint64(math.MaxInt64)+1, 10)
+		return uint64(math.MaxInt64) + 1
+	}
+	if amount < 0 {
+		return uint64(-amount)
 	}
-	return strconv.FormatInt(-amount, 10)
+	return uint64(amount)
 }
@@ -1,6 +1,159 @@
 package money
 
-import "testing"
+import (
+	"errors"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestWithAmount(t *testing.T) {
+	bhd := Currency{Code: "BHD", Scale: 3, Symbol: "BD"}
+	orig := New(1050, bhd)
+	out := orig.WithAmount(-7)
+
+	if got := out.Amount(); got != -7 {
+		t.Fatalf("amount = %d", got)
+	}
+	if got := out.Currency(); got != bhd {
+		t.Fatalf("currency = %+v", got)
+	}
+	if got := orig.Amount(); got != 1050 {
+		t.Fatalf("original amount = %d", got)
+	}
+}
+
+func TestAmountAtScale(t *testing.T) {
+	jpy := Currency{Code: "JPY", Scale: 0, Symbol: "¥"}
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+
+	tests := []struct {
+		m     Money
+		scale int32
+		want  int64
+	}{
+		{New(123, jpy), 2, 12300},
+		{New(-123, jpy), 4, -1230000},
+		{New(1050, usd), 2, 1050},
+		{New(1055, usd), 1, 106},
+		{New(1045, usd), 1, 104},
+		{New(-1055, usd), 0, -11},
+		{New(-49, usd), 0, 0},
+	}
+	for _, tt := range tests {
+		got, err := tt.m.AmountAtScale(tt.scale)
+		if err != nil {
+			t.Fatalf("amount at scale %d: %v", tt.scale, err)
+		}
+		if got != tt.want {
+			t.Fatalf("amount %d at scale %d = %d, want %d", tt.m.Amount(), tt.scale, got, tt.want)
+		}
+	}
+
+	if _, err := New(math.MaxInt64, jpy).AmountAtScale(2); err != ErrInvalidOperation {
+		t.Fatalf("expected ErrInvalidOperation, got %v", err)
+	}
+	if _, err := New(1, usd).AmountAtScale(-1); err != ErrInvalidOperation {
+		t.Fatalf("expected ErrInvalidOperation, got %v", err)
+	}
+}
+
+func TestMigrateScale(t *testing.T) {
+	tests := []struct {
+		amount   int64
+		from, to int32
+		mode     RoundingMode
+		want     int64
+	}{
+		{1050, 2, 4, RoundHalfEven, 105000},
+		{-1050, 2, 4, RoundHalfEven, -105000},
+		{105049, 4, 2, RoundHalfEven, 1050},
+		{105050, 4, 2, RoundHalfEven, 1050},
+		{105150, 4, 2, RoundHalfEven, 1052},
+		{105050, 4, 2, RoundHalfUp, 1051},
+		{-105050, 4, 2, RoundHalfUp, -1050},
+		{105099, 4, 2, RoundTowardZero, 1050},
+		{-105001, 4, 2, RoundFloor, -1051},
+		{105001, 4, 2, RoundCeiling, 1051},
+		{1050, 2, 2, RoundHalfEven, 1050},
+	}
+	for _, tt := range tests {
+		got, err := MigrateScale(tt.amount, tt.from, tt.to, tt.mode)
+		if err != nil {
+			t.Fatalf("migrate %d: %v", tt.amount, err)
+		}
+		if got != tt.want {
+			t.Fatalf("migrate %d from %d to %d (mode %d) = %d, want %d", tt.amount, tt.from, tt.to, tt.mode, got, tt.want)
+		}
+	}
+
+	for _, bad := range []struct{ from, to int32 }{{2, 18}, {-1, 2}, {2, -1}} {
+		if _, err := MigrateScale(math.MaxInt64/10, bad.from, bad.to, RoundHalfEven); err != ErrInvalidOperation {
+			t.Fatalf("migrate %d->%d: expected ErrInvalidOperation, got %v", bad.from, bad.to, err)
+		}
+	}
+	if _, err := MigrateScale(1, 2, 0, RoundingMode(99)); err != ErrInvalidOperation {
+		t.Fatalf("expected ErrInvalidOperation for unknown mode, got %v", err)
+	}
+}
+
+func TestMinorUnitsAtScale2(t *testing.T) {
+	jpy := Currency{Code: "JPY", Scale: 0, Symbol: "¥"}
+	bhd := Currency{Code: "BHD", Scale: 3, Symbol: "BD"}
+
+	tests := []struct {
+		m    Money
+		want int64
+	}{
+		{New(123, jpy), 12300},
+		{New(-5, jpy), -500},
+		{New(1004, bhd), 100},
+		{New(1005, bhd), 100},
+		{New(1015, bhd), 102},
+		{New(1006, bhd), 101},
+		{New(-1006, bhd), -101},
+		{New(math.MaxInt64, jpy), math.MaxInt64},
+		{New(math.MinInt64, jpy), math.MinInt64},
+		{New(5, Currency{Code: "XTS", Scale: -1}), 0},
+		{New(-5, Currency{Code: "XTS", Scale: 19}), 0},
+	}
+	for _, tt := range tests {
+		if got := tt.m.MinorUnitsAtScale2(); got != tt.want {
+			t.Fatalf("%s %d at scale 2 = %d, want %d", tt.m.Currency().Code, tt.m.Amount(), got, tt.want)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+	for _, m := range []Money{New(1050, usd), New(math.MinInt64, usd), New(math.MaxInt64, Currency{Code: "XTS", Scale: 18})} {
+		if err := m.Validate(); err != nil {
+			t.Fatalf("validate %d: %v", m.Amount(), err)
+		}
+	}
+
+	// A valid currency is all display needs, whatever the amount.
+	for scale := int32(0); scale <= 18; scale++ {
+		for _, amount := range []int64{math.MinInt64, math.MaxInt64} {
+			m := New(amount, Currency{Code: "XTS", Scale: scale})
+			if err := m.Validate(); err != nil {
+				t.Fatalf("validate %d at scale %d: %v", amount, scale, err)
+			}
+			if _, err := m.Format(USDFormat().With(WithMaxFractionDigits(1))); err != nil {
+				t.Fatalf("format %d at scale %d: %v", amount, scale, err)
+			}
+		}
+	}
+
+	corrupted := New(1050, Currency{Code: "USD", Scale: -2, Symbol: "$"})
+	err := corrupted.Validate()
+	if !errors.Is(err, ErrInvalidOperation) {
+		t.Fatalf("expected ErrInvalidOperation, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "scale -2") {
+		t.Fatalf("error %q does not describe the scale", err)
+	}
+}
 
 func TestAddSub(t *testing.T) {
 	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
@@ -54,6 +207,174 @@ func TestPercentExample(t *testing.T) {
 	}
 }
 
+func TestAddPercentOf(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+	eur := Currency{Code: "EUR", Scale: 2, Symbol: "€"}
+
+	tests := []struct {
+		m, base int64
+		percent int64
+		want    int64
+	}{
+		{5000, 20000, 10, 7000},
+		{0, 1005, 10, 100},
+		{0, 1015, 10, 102},
+		{5000, -20000, 10, 3000},
+		{5000, 20000, -5, 4000},
+	}
+	for _, tt := range tests {
+		got, err := New(tt.m, usd).AddPercentOf(tt.percent, New(tt.base, usd))
+		if err != nil {
+			t.Fatalf("add percent of: %v", err)
+		}
+		if got.Amount() != tt.want {
+			t.Fatalf("%d + %d%% of %d = %d, want %d", tt.m, tt.percent, tt.base, got.Amount(), tt.want)
+		}
+	}
+
+	if _, err := New(5000, usd).AddPercentOf(10, New(20000, eur)); err != ErrCurrencyMismatch {
+		t.Fatalf("expected ErrCurrencyMismatch, got %v", err)
+	}
+	if _, err := New(math.MaxInt64, usd).AddPercentOf(1, New(10000, usd)); err != ErrInvalidOperation {
+		t.Fatalf("expected ErrInvalidOperation, got %v", err)
+	}
+}
+
+func TestSubtractPercentPoints(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+	price := New(10000, usd)
+
+	stacked, err := price.SubtractPercentPoints(10, 5)
+	if err != nil {
+		t.Fatalf("subtract percent points error: %v", err)
+	}
+	single, err := price.SubtractPercent(15)
+	if err != nil {
+		t.Fatalf("subtract percent error: %v", err)
+	}
+	if !stacked.Equal(single) || stacked.Amount() != 8500 {
+		t.Fatalf("stacked = %d, single = %d", stacked.Amount(), single.Amount())
+	}
+
+	capped, err := price.SubtractPercentPoints(60, 70)
+	if err != nil {
+		t.Fatalf("subtract percent points error: %v", err)
+	}
+	if !capped.IsZero() {
+		t.Fatalf("capped = %d", capped.Amount())
+	}
+
+	if _, err := price.SubtractPercentPoints(math.MaxInt64, 1); err != ErrInvalidOperation {
+		t.Fatalf("expected ErrInvalidOperation, got %v", err)
+	}
+}
+
+func TestSubtractPercentCompound(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+
+	tests := []struct {
+		amount   int64
+		percents []int64
+		want     int64
+		additive int64
+	}{
+		{10000, []int64{10, 5}, 8550, 8500},
+		// 999 -> 899.1 -> 899 -> 854.05 -> 854, against 999 * 0.85 = 849.15.
+		{999, []int64{10, 5}, 854, 849},
+		{10000, []int64{60, 70}, 1200, 0},
+		{10000, []int64{150, 5}, 0, 0},
+		{10000, nil, 10000, 10000},
+	}
+	for _, tt := range tests {
+		got, err := New(tt.amount, usd).SubtractPercentCompound(tt.percents...)
+		if err != nil {
+			t.Fatalf("compound %v: %v", tt.percents, err)
+		}
+		additive, err := New(tt.amount, usd).SubtractPercentPoints(tt.percents...)
+		if err != nil {
+			t.Fatalf("points %v: %v", tt.percents, err)
+		}
+		if got.Amount() != tt.want || additive.Amount() != tt.additive {
+			t.Fatalf("%d less %v = %d compound, %d additive; want %d, %d", tt.amount, tt.percents, got.Amount(), additive.Amount(), tt.want, tt.additive)
+		}
+	}
+}
+
+func TestSubtractPercentClamped(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+	price := New(1000, usd)
+
+	clamped, err := price.SubtractPercentClamped(150)
+	if err != nil {
+		t.Fatalf("subtract percent clamped error: %v", err)
+	}
+	if !clamped.IsZero() {
+		t.Fatalf("clamped = %d, want 0", clamped.Amount())
+	}
+	unclamped, err := price.SubtractPercent(150)
+	if err != nil || unclamped.Amount() != -500 {
+		t.Fatalf("subtract percent = %d, %v", unclamped.Amount(), err)
+	}
+
+	partial, err := price.SubtractPercentClamped(25)
+	if err != nil || partial.Amount() != 750 {
+		t.Fatalf("partial = %d, %v", partial.Amount(), err)
+	}
+
+	if _, err := price.SubtractPercentClamped(-50); err != ErrInvalidOperation {
+		t.Fatalf("negative percent: expected ErrInvalidOperation, got %v", err)
+	}
+
+	if _, err := price.SubtractPercentStrict(150); err != ErrInvalidOperation {
+		t.Fatalf("expected ErrInvalidOperation, got %v", err)
+	}
+	if _, err := price.SubtractPercentStrict(-1); err != ErrInvalidOperation {
+		t.Fatalf("expected ErrInvalidOperation, got %v", err)
+	}
+	full, err := price.SubtractPercentStrict(100)
+	if err != nil || !full.IsZero() {
+		t.Fatalf("strict 100 = %d, %v", full.Amount(), err)
+	}
+}
+
+func TestGrowByPercent(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+
+	out, err := New(100000, usd).GrowByPercent(10, 3, true)
+	if err != nil {
+		t.Fatalf("grow error: %v", err)
+	}
+	if got := out.Amount(); got != 133100 {
+		t.Fatalf("grow amount = %d", got)
+	}
+
+	perPeriod, err := New(5, usd).GrowByPercent(7, 5, true)
+	if err != nil {
+		t.Fatalf("grow error: %v", err)
+	}
+	once, err := New(5, usd).GrowByPercent(7, 5, false)
+	if err != nil {
+		t.Fatalf("grow error: %v", err)
+	}
+	if perPeriod.Amount() != 5 || once.Amount() != 7 {
+		t.Fatalf("per period = %d, once = %d", perPeriod.Amount(), once.Amount())
+	}
+
+	if _, err := New(5, usd).GrowByPercent(7, -1, true); err != ErrInvalidOperation {
+		t.Fatalf("expected ErrInvalidOperation, got %v", err)
+	}
+
+	for _, perPeriod := range []bool{true, false} {
+		wiped, err := New(100000, usd).GrowByPercent(-100, 3, perPeriod)
+		if err != nil || !wiped.IsZero() {
+			t.Fatalf("grow by -100%% (per period %v) = %d, %v", perPeriod, wiped.Amount(), err)
+		}
+		if _, err := New(100000, usd).GrowByPercent(-200, 3, perPeriod); err != ErrInvalidOperation {
+			t.Fatalf("grow by -200%% (per period %v): expected ErrInvalidOperation, got %v", perPeriod, err)
+		}
+	}
+}
+
 func TestComparisons(t *testing.T) {
 	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
 	a := New(500, usd)
@@ -78,6 +399,172 @@ func TestComparisons(t *testing.T) {
 	}
 }
 
+func TestEqualStrict(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+	eur := Currency{Code: "EUR", Scale: 2, Symbol: "€"}
+
+	eq, err := New(500, usd).EqualStrict(New(500, usd))
+	if err != nil || !eq {
+		t.Fatalf("equal strict = %v, %v", eq, err)
+	}
+	eq, err = New(500, usd).EqualStrict(New(700, usd))
+	if err != nil || eq {
+		t.Fatalf("equal strict = %v, %v", eq, err)
+	}
+	_, err = New(500, usd).EqualStrict(New(500, eur))
+	if err != ErrCurrencyMismatch {
+		t.Fatalf("expected ErrCurrencyMismatch, got %v", err)
+	}
+	if New(500, usd).Equal(New(500, eur)) {
+		t.Fatalf("expected lenient Equal to report false")
+	}
+}
+
+func TestEqualValue(t *testing.T) {
+	usd2 := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+	usd3 := Currency{Code: "USD", Scale: 3, Symbol: "US$"}
+	eur := Currency{Code: "EUR", Scale: 2, Symbol: "€"}
+
+	eq, err := New(1050, usd2).EqualValue(New(10500, usd3))
+	if err != nil || !eq {
+		t.Fatalf("equal value = %v, %v", eq, err)
+	}
+	if New(1050, usd2).Equal(New(10500, usd3)) {
+		t.Fatalf("expected representation inequality")
+	}
+	eq, err = New(1050, usd2).EqualValue(New(10501, usd3))
+	if err != nil || eq {
+		t.Fatalf("equal value = %v, %v", eq, err)
+	}
+	if _, err := New(1050, usd2).EqualValue(New(1050, eur)); err != ErrCurrencyMismatch {
+		t.Fatalf("expected ErrCurrencyMismatch, got %v", err)
+	}
+}
+
+func TestMaxMin(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+	eur := Currency{Code: "EUR", Scale: 2, Symbol: "€"}
+	a := New(500, usd)
+	b := New(-700, usd)
+
+	hi, err := a.Max(b)
+	if err != nil || hi.Amount() != 500 {
+		t.Fatalf("max = %d, %v", hi.Amount(), err)
+	}
+	lo, err := a.Min(b)
+	if err != nil || lo.Amount() != -700 {
+		t.Fatalf("min = %d, %v", lo.Amount(), err)
+	}
+	same, err := a.Max(New(500, usd))
+	if err != nil || !same.Equal(a) {
+		t.Fatalf("max equal = %v, %v", same, err)
+	}
+	same, err = a.Min(New(500, usd))
+	if err != nil || !same.Equal(a) {
+		t.Fatalf("min equal = %v, %v", same, err)
+	}
+
+	if _, err := a.Max(New(500, eur)); err != ErrCurrencyMismatch {
+		t.Fatalf("expected ErrCurrencyMismatch, got %v", err)
+	}
+	if _, err := a.Min(New(500, eur)); err != ErrCurrencyMismatch {
+		t.Fatalf("expected ErrCurrencyMismatch, got %v", err)
+	}
+}
+
+func TestClampMinMax(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+	eur := Currency{Code: "EUR", Scale: 2, Symbol: "€"}
+	limit := New(1000, usd)
+
+	tests := []struct {
+		amount   int64
+		min, max int64
+	}{
+		{-250, 0, -250},
+		{0, 0, 0},
+		{500, 500, 500},
+		{1500, 1500, 1000},
+	}
+	for _, tt := range tests {
+		m := New(tt.amount, usd)
+		lo, err := m.ClampMin(Zero(usd))
+		if err != nil || lo.Amount() != tt.min {
+			t.Fatalf("%d clamp min = %d, %v; want %d", tt.amount, lo.Amount(), err, tt.min)
+		}
+		hi, err := m.ClampMax(limit)
+		if err != nil || hi.Amount() != tt.max {
+			t.Fatalf("%d clamp max = %d, %v; want %d", tt.amount, hi.Amount(), err, tt.max)
+		}
+	}
+
+	if _, err := limit.ClampMin(Zero(eur)); err != ErrCurrencyMismatch {
+		t.Fatalf("expected ErrCurrencyMismatch, got %v", err)
+	}
+	if _, err := limit.ClampMax(Zero(eur)); err != ErrCurrencyMismatch {
+		t.Fatalf("expected ErrCurrencyMismatch, got %v", err)
+	}
+}
+
+func TestAbsNegate(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+
+	abs, err := New(-1050, usd).Abs()
+	if err != nil || abs.Amount() != 1050 {
+		t.Fatalf("abs = %d, %v", abs.Amount(), err)
+	}
+	neg, err := New(1050, usd).Negate()
+	if err != nil || neg.Amount() != -1050 {
+		t.Fatalf("negate = %d, %v", neg.Amount(), err)
+	}
+	if _, err := New(math.MinInt64, usd).Abs(); err != ErrInvalidOperation {
+		t.Fatalf("expected ErrInvalidOperation, got %v", err)
+	}
+	if _, err := New(math.MinInt64, usd).Negate(); err != ErrInvalidOperation {
+		t.Fatalf("expected ErrInvalidOperation, got %v", err)
+	}
+}
+
+func TestAbsDiff(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+	eur := Currency{Code: "EUR", Scale: 2, Symbol: "€"}
+
+	tests := []struct {
+		a, b int64
+		want int64
+	}{
+		{1050, 250, 800},
+		{-1050, 250, 1300},
+		{-1050, -250, 800},
+		{500, 500, 0},
+		{math.MinInt64, -1, math.MaxInt64},
+		{math.MaxInt64, 0, math.MaxInt64},
+		{math.MaxInt64, 1, math.MaxInt64 - 1},
+	}
+	for _, tt := range tests {
+		ab, err := New(tt.a, usd).AbsDiff(New(tt.b, usd))
+		if err != nil {
+			t.Fatalf("|%d - %d|: %v", tt.a, tt.b, err)
+		}
+		ba, err := New(tt.b, usd).AbsDiff(New(tt.a, usd))
+		if err != nil {
+			t.Fatalf("|%d - %d|: %v", tt.b, tt.a, err)
+		}
+		if ab.Amount() != tt.want || !ab.Equal(ba) {
+			t.Fatalf("|%d - %d| = %d and %d, want %d", tt.a, tt.b, ab.Amount(), ba.Amount(), tt.want)
+		}
+	}
+
+	for _, pair := range [][2]int64{{math.MinInt64, 0}, {math.MaxInt64, -1}, {math.MaxInt64, math.MinInt64}} {
+		if _, err := New(pair[0], usd).AbsDiff(New(pair[1], usd)); err != ErrInvalidOperation {
+			t.Fatalf("|%d - %d|: expected ErrInvalidOperation, got %v", pair[0], pair[1], err)
+		}
+	}
+	if _, err := New(1, usd).AbsDiff(New(1, eur)); err != ErrCurrencyMismatch {
+		t.Fatalf("expected ErrCurrencyMismatch, got %v", err)
+	}
+}
+
 func TestMul(t *testing.T) {
 	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
 	m := New(1050, usd)
@@ -91,6 +578,35 @@ func TestMul(t *testing.T) {
 	}
 }
 
+func TestMulOverflow(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+	tests := []struct {
+		amount int64
+		factor int64
+		want   int64
+		err    error
+	}{
+		{math.MaxInt64 / 2, 2, math.MaxInt64 - 1, nil},
+		{math.MaxInt64/2 + 1, 2, 0, ErrInvalidOperation},
+		{math.MaxInt64 / 3, 3, math.MaxInt64 - 1, nil},
+		{math.MaxInt64/3 + 1, 3, 0, ErrInvalidOperation},
+		{math.MinInt64 / 2, 2, math.MinInt64, nil},
+		{math.MinInt64 / 2, -2, 0, ErrInvalidOperation},
+		{math.MaxInt64, -1, -math.MaxInt64, nil},
+		{math.MinInt64, -1, 0, ErrInvalidOperation},
+		{math.MaxInt64, 3, 0, ErrInvalidOperation},
+	}
+	for _, tt := range tests {
+		out, err := New(tt.amount, usd).Mul(tt.factor)
+		if err != tt.err {
+			t.Fatalf("Mul(%d, %d) error = %v, want %v", tt.amount, tt.factor, err, tt.err)
+		}
+		if err == nil && out.Amount() != tt.want {
+			t.Fatalf("Mul(%d, %d) = %d, want %d", tt.amount, tt.factor, out.Amount(), tt.want)
+		}
+	}
+}
+
 func TestDiv(t *testing.T) {
 	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
 	m := New(2100, usd)
@@ -104,6 +620,242 @@ func TestDiv(t *testing.T) {
 	}
 }
 
+func TestDivByZero(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+
+	_, err := New(2100, usd).Div(0)
+	if !errors.Is(err, ErrDivideByZero) {
+		t.Fatalf("expected ErrDivideByZero, got %v", err)
+	}
+	if !errors.Is(err, ErrInvalidOperation) {
+		t.Fatalf("expected ErrDivideByZero to wrap ErrInvalidOperation")
+	}
+
+	_, err = PipeOf(New(2100, usd)).Div(0).Result()
+	if !errors.Is(err, ErrDivideByZero) {
+		t.Fatalf("expected pipe ErrDivideByZero, got %v", err)
+	}
+}
+
+func TestDivDecimal(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+	jpy := Currency{Code: "JPY", Scale: 0, Symbol: "¥"}
+
+	tests := []struct {
+		m       Money
+		divisor int64
+		want    string
+	}{
+		{New(1000, usd), 3, "3.333333333333333333"},
+		{New(-1000, usd), 3, "-3.333333333333333333"},
+		{New(1000, usd), 2, "5.00"},
+		{New(1000, usd), 8, "1.25"},
+		{New(1, usd), 8, "0.00125"},
+		{New(100, jpy), 7, "14.28571428571428571"},
+	}
+	for _, tt := range tests {
+		got, err := tt.m.DivDecimal(tt.divisor)
+		if err != nil {
+			t.Fatalf("div decimal %d/%d: %v", tt.m.Amount(), tt.divisor, err)
+		}
+		if got != tt.want {
+			t.Fatalf("div decimal %d/%d = %q, want %q", tt.m.Amount(), tt.divisor, got, tt.want)
+		}
+	}
+
+	if _, err := New(1000, usd).DivDecimal(0); !errors.Is(err, ErrDivideByZero) {
+		t.Fatalf("expected ErrDivideByZero, got %v", err)
+	}
+}
+
+func TestDivToScale(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+
+	tests := []struct {
+		amount  int64
+		divisor int64
+		scale   int32
+		want    int64
+	}{
+		{1000, 3, 4, 33333},
+		{2000, 3, 4, 66667},
+		{-1000, 3, 4, -33333},
+		{1000, -3, 4, -33333},
+		{1000, 3, 2, 333},
+		{1, 8, 4, 12},
+		{1, 8, 5, 125},
+		{math.MinInt64, math.MinInt64, 6, 10000},
+	}
+	for _, tt := range tests {
+		out, err := New(tt.amount, usd).DivToScale(tt.divisor, tt.scale)
+		if err != nil {
+			t.Fatalf("%d / %d at scale %d: %v", tt.amount, tt.divisor, tt.scale, err)
+		}
+		if out.Amount() != tt.want || out.Currency().Scale != tt.scale || out.Currency().Code != "USD" {
+			t.Fatalf("%d / %d at scale %d = %d %+v, want %d", tt.amount, tt.divisor, tt.scale, out.Amount(), out.Currency(), tt.want)
+		}
+	}
+
+	if _, err := New(1000, usd).DivToScale(0, 4); !errors.Is(err, ErrDivideByZero) {
+		t.Fatalf("expected ErrDivideByZero, got %v", err)
+	}
+	for _, scale := range []int32{1, 19} {
+		if _, err := New(1000, usd).DivToScale(3, scale); err != ErrInvalidOperation {
+			t.Fatalf("scale %d: expected ErrInvalidOperation, got %v", scale, err)
+		}
+	}
+	if _, err := New(math.MaxInt64, usd).DivToScale(3, 6); err != ErrInvalidOperation {
+		t.Fatalf("expected ErrInvalidOperation on overflow, got %v", err)
+	}
+}
+
+func TestEqualWithinPercent(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+	eur := Currency{Code: "EUR", Scale: 2, Symbol: "€"}
+	ref := New(10000, usd)
+
+	tests := []struct {
+		m       int64
+		x       int64
+		percent int64
+		want    bool
+	}{
+		{10100, 10000, 1, true},
+		{9900, 10000, 1, true},
+		{10101, 10000, 1, false},
+		{9899, 10000, 1, false},
+		{-10000, -10050, 1, true},
+		{0, 0, 1, true},
+		{1, 0, 100, false},
+		{10000, 10000, 0, true},
+		{math.MaxInt64, math.MinInt64, 199, false},
+		{math.MaxInt64, math.MinInt64, 200, true},
+	}
+	for _, tt := range tests {
+		got, err := New(tt.m, usd).EqualWithinPercent(New(tt.x, usd), tt.percent)
+		if err != nil {
+			t.Fatalf("equal within percent: %v", err)
+		}
+		if got != tt.want {
+			t.Fatalf("%d within %d%% of %d = %v, want %v", tt.m, tt.percent, tt.x, got, tt.want)
+		}
+	}
+
+	if _, err := ref.EqualWithinPercent(New(10000, eur), 1); err != ErrCurrencyMismatch {
+		t.Fatalf("expected ErrCurrencyMismatch, got %v", err)
+	}
+	if _, err := ref.EqualWithinPercent(ref, -1); err != ErrInvalidOperation {
+		t.Fatalf("expected ErrInvalidOperation, got %v", err)
+	}
+}
+
+func TestEqualPtr(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+	eur := Currency{Code: "EUR", Scale: 2, Symbol: "€"}
+	zero := Zero(usd)
+	a, b := New(500, usd), New(500, usd)
+	other := New(500, eur)
+
+	tests := []struct {
+		a, b *Money
+		want bool
+	}{
+		{nil, nil, true},
+		{nil, &zero, false},
+		{&zero, nil, false},
+		{&a, &b, true},
+		{&a, &a, true},
+		{&a, &zero, false},
+		{&a, &other, false},
+	}
+	for i, tt := range tests {
+		if got := EqualPtr(tt.a, tt.b); got != tt.want {
+			t.Fatalf("case %d: EqualPtr = %v, want %v", i, got, tt.want)
+		}
+	}
+
+	if !IsNilOrZero(nil) || !IsNilOrZero(&zero) || IsNilOrZero(&a) {
+		t.Fatalf("IsNilOrZero = %v, %v, %v", IsNilOrZero(nil), IsNilOrZero(&zero), IsNilOrZero(&a))
+	}
+}
+
+func TestAsKey(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+	usdAlt := Currency{Code: "USD", Scale: 2, Symbol: "US$"}
+	usd4 := Currency{Code: "USD", Scale: 4, Symbol: "$"}
+
+	if New(500, usd) == New(500, usdAlt) {
+		t.Fatalf("Money values differing in symbol compare equal")
+	}
+	if New(500, usd).AsKey() != New(500, usdAlt).AsKey() {
+		t.Fatalf("keys differ only by symbol: %+v, %+v", New(500, usd).AsKey(), New(500, usdAlt).AsKey())
+	}
+	if got, want := New(500, usd).AsKey(), (Key{Code: "USD", Scale: 2, Amount: 500}); got != want {
+		t.Fatalf("key = %+v, want %+v", got, want)
+	}
+	for _, other := range []Money{New(501, usd), New(500, usd4), New(500, Currency{Code: "EUR", Scale: 2})} {
+		if New(500, usd).AsKey() == other.AsKey() {
+			t.Fatalf("key of %v equals key of %v", other, New(500, usd))
+		}
+	}
+
+	counts := map[Key]int{}
+	for _, m := range []Money{New(500, usd), New(500, usdAlt), New(700, usd)} {
+		counts[m.AsKey()]++
+	}
+	if len(counts) != 2 || counts[New(500, usd).AsKey()] != 2 {
+		t.Fatalf("counts = %v", counts)
+	}
+}
+
+func TestCompareInt(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+
+	tests := []struct {
+		m     Money
+		minor int64
+		want  int
+	}{
+		{New(1050, usd), 1050, 0},
+		{New(1050, usd), 2000, -1},
+		{New(1050, usd), -1050, 1},
+		{Zero(usd), 0, 0},
+		{New(math.MinInt64, usd), math.MaxInt64, -1},
+	}
+	for _, tt := range tests {
+		if got := tt.m.CompareInt(tt.minor); got != tt.want {
+			t.Fatalf("compare %d with %d = %d, want %d", tt.m.Amount(), tt.minor, got, tt.want)
+		}
+		if got := tt.m.EqualInt(tt.minor); got != (tt.want == 0) {
+			t.Fatalf("equal %d with %d = %v", tt.m.Amount(), tt.minor, got)
+		}
+	}
+}
+
+func TestIsWholeUnit(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+	jpy := Currency{Code: "JPY", Scale: 0, Symbol: "¥"}
+	huge := Currency{Code: "XTS", Scale: 30}
+
+	tests := []struct {
+		m    Money
+		want bool
+	}{
+		{New(1000, usd), true},
+		{New(1050, usd), false},
+		{New(-1000, usd), true},
+		{New(-1, usd), false},
+		{New(123, jpy), true},
+		{New(0, huge), true},
+		{New(math.MaxInt64, huge), false},
+	}
+	for _, tt := range tests {
+		if got := tt.m.IsWholeUnit(); got != tt.want {
+			t.Fatalf("IsWholeUnit(%d, scale %d) = %v", tt.m.Amount(), tt.m.Currency().Scale, got)
+		}
+	}
+}
+
 func TestStringFormatting(t *testing.T) {
 	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
 	m := New(-105, usd)
@@ -117,6 +869,31 @@ func TestStringFormatting(t *testing.T) {
 	}
 }
 
+func TestStringFallback(t *testing.T) {
+	orig := DefaultFormat()
+	defer func() {
+		if err := SetFormat(orig); err != nil {
+			t.Fatalf("reset format: %v", err)
+		}
+	}()
+	cfg := orig
+	cfg.MaxFractionDigits = 2
+	if err := SetFormat(cfg); err != nil {
+		t.Fatalf("set format: %v", err)
+	}
+
+	bad := New(1050, Currency{Code: "USD", Scale: 40, Symbol: "$"})
+	if _, err := bad.Format(cfg); err == nil {
+		t.Fatalf("expected format failure for scale 40")
+	}
+	if got, want := bad.String(), "<invalid money: USD scale=40 amount=1050>"; got != want {
+		t.Fatalf("string = %q, want %q", got, want)
+	}
+	if got := New(1050, Currency{Code: "USD", Scale: 2, Symbol: "$"}).String(); got != "$10.50" {
+		t.Fatalf("string = %q", got)
+	}
+}
+
 func TestFormatConfig(t *testing.T) {
 	orig := DefaultFormat()
 	defer func() {
@@ -178,3 +955,51 @@ func TestFormatGrouping(t *testing.T) {
 		t.Fatalf("format = %s", text)
 	}
 }
+
+func TestClone(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+	orig := New(1050, usd)
+	clone := orig.Clone()
+	if !clone.Equal(orig) {
+		t.Fatalf("clone = %v, want %v", clone, orig)
+	}
+
+	derived, err := clone.Add(New(250, usd))
+	if err != nil {
+		t.Fatalf("add error: %v", err)
+	}
+	if got := derived.Amount(); got != 1300 {
+		t.Fatalf("derived amount = %d", got)
+	}
+	if got := orig.Amount(); got != 1050 {
+		t.Fatalf("original amount = %d", got)
+	}
+	if got := clone.Amount(); got != 1050 {
+		t.Fatalf("clone amount = %d", got)
+	}
+}
+
+func TestNegativeZeroNormalization(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+
+	tiny, err := New(-4, usd).Div(10)
+	if err != nil {
+		t.Fatalf("div error: %v", err)
+	}
+	discounted, err := New(-1, usd).SubtractPercent(60)
+	if err != nil {
+		t.Fatalf("subtract percent error: %v", err)
+	}
+
+	for _, m := range []Money{tiny, discounted, tiny.Normalized()} {
+		if !m.IsZero() || m.IsNegative() {
+			t.Fatalf("expected zero, got %d", m.Amount())
+		}
+		if got := m.String(); got != "$0.00" {
+			t.Fatalf("string = %s", got)
+		}
+	}
+	if got := New(-1050, usd).Normalized().Amount(); got != -1050 {
+		t.Fatalf("normalized amount = %d", got)
+	}
+}
@@ -0,0 +1,217 @@
+package money
+
+// FormatOption modifies a FormatConfig; see FormatOverride.
+// Example: m.FormatOverride(WithSymbolCode(), WithSpace(true)).
+type FormatOption func(*FormatConfig)
+
+// FormatOverride renders Money starting from DefaultFormat and applying the
+// overrides in order; fields not overridden inherit the global default.
+// Example: New(1050, USD).FormatOverride(WithSymbolCode(), WithSuffix(), WithSpace(true)) -> "10.50 USD".
+func (m Money) FormatOverride(overrides ...FormatOption) (string, error) {
+	return m.Format(DefaultFormat().With(overrides...))
+}
+
+// With returns a copy of c with the options applied in order; c is unchanged.
+// Example: DefaultFormat().With(WithSuffix(), WithSpace(true)).
+func (c FormatConfig) With(opts ...FormatOption) FormatConfig {
+	for _, opt := range opts {
+		opt(&c)
+	}
+	return c
+}
+
+// WithDecimalSeparator sets FormatConfig.DecimalSeparator.
+// Example: New(1050, USD).FormatOverride(WithDecimalSeparator(",")) -> "$10,50".
+func WithDecimalSeparator(sep string) FormatOption {
+	return func(c *FormatConfig) { c.DecimalSeparator = sep }
+}
+
+// WithThousandsSeparator sets FormatConfig.ThousandsSeparator.
+// Example: New(123456, USD).FormatOverride(WithThousandsSeparator(",")) -> "$1,234.56".
+func WithThousandsSeparator(sep string) FormatOption {
+	return func(c *FormatConfig) { c.ThousandsSeparator = sep }
+}
+
+// WithSymbolPosition sets FormatConfig.SymbolPosition.
+// Example: New(1050, USD).FormatOverride(WithSymbolPosition(SymbolSuffix)) -> "10.50$".
+func WithSymbolPosition(pos SymbolPosition) FormatOption {
+	return func(c *FormatConfig) { c.SymbolPosition = pos }
+}
+
+// WithPrefix places the symbol before the amount.
+// Example: New(1050, USD).FormatOverride(WithPrefix()) -> "$10.50".
+func WithPrefix() FormatOption {
+	return WithSymbolPosition(SymbolPrefix)
+}
+
+// WithSuffix places the symbol after the amount.
+// Example: New(1050, USD).FormatOverride(WithSuffix(), WithSpace(true)) -> "10.50 $".
+func WithSuffix() FormatOption {
+	return WithSymbolPosition(SymbolSuffix)
+}
+
+// WithSymbolKind sets FormatConfig.SymbolKind.
+// Example: New(1050, USD).FormatOverride(WithSymbolKind(SymbolUseCurrencyCode)) -> "USD10.50".
+func WithSymbolKind(kind SymbolKind) FormatOption {
+	return func(c *FormatConfig) { c.SymbolKind = kind }
+}
+
+// WithSymbolCode renders the currency code instead of the symbol.
+// Example: New(-1050, USD).FormatOverride(WithSymbolCode(), WithSuffix(), WithSpace(true)) -> "-10.50 USD".
+func WithSymbolCode() FormatOption {
+	return WithSymbolKind(SymbolUseCurrencyCode)
+}
+
+// WithCustomSymbol renders the given symbol and selects SymbolUseCustom.
+// Example: New(1050, USD).FormatOverride(WithCustomSymbol("US$")) -> "US$10.50".
+func WithCustomSymbol(symbol string) FormatOption {
+	return func(c *FormatConfig) {
+		c.SymbolKind = SymbolUseCustom
+		c.CustomSymbol = symbol
+	}
+}
+
+// WithSpace sets FormatConfig.Space.
+// Example: New(1050, USD).FormatOverride(WithSpace(true)) -> "$ 10.50".
+func WithSpace(space bool) FormatOption {
+	return func(c *FormatConfig) { c.Space = space }
+}
+
+// WithNegativeParens sets FormatConfig.NegativeParens.
+// Example: New(-1050, USD).FormatOverride(WithNegativeParens(true)) -> "($10.50)".
+func WithNegativeParens(parens bool) FormatOption {
+	return func(c *FormatConfig) { c.NegativeParens = parens }
+}
+
+// WithMinIntegerDigits sets FormatConfig.MinIntegerDigits.
+// Example: New(1050, USD).FormatOverride(WithMinIntegerDigits(4)) -> "$0010.50".
+func WithMinIntegerDigits(n int) FormatOption {
+	return func(c *FormatConfig) { c.MinIntegerDigits = n }
+}
+
+// WithTrimTrailingZeros sets FormatConfig.TrimTrailingZeros.
+// Example: New(1000, USD).FormatOverride(WithTrimTrailingZeros(true)) -> "$10".
+func WithTrimTrailingZeros(trim bool) FormatOption {
+	return func(c *FormatConfig) { c.TrimTrailingZeros = trim }
+}
+
+// WithMinFractionDigits sets FormatConfig.MinFractionDigits.
+// Example: New(1000, USD).FormatOverride(WithTrimTrailingZeros(true), WithMinFractionDigits(1)) -> "$10.0".
+func WithMinFractionDigits(n int) FormatOption {
+	return func(c *FormatConfig) { c.MinFractionDigits = n }
+}
+
+// WithMaxFractionDigits sets FormatConfig.MaxFractionDigits.
+// Example: New(1055, USD).FormatOverride(WithMaxFractionDigits(1)) -> "$10.6".
+func WithMaxFractionDigits(n int) FormatOption {
+	return func(c *FormatConfig) { c.MaxFractionDigits = n }
+}
+
+// WithZeroText sets FormatConfig.ZeroText.
+// Example: Zero(USD).FormatOverride(WithZeroText("free")) -> "free".
+func WithZeroText(text string) FormatOption {
+	return func(c *FormatConfig) { c.ZeroText = text }
+}
+
+// WithNegativePrefix sets FormatConfig.NegativePrefix.
+// Example: New(-1050, USD).FormatOverride(WithNegativePrefix("<")) -> "<-$10.50".
+func WithNegativePrefix(prefix string) FormatOption {
+	return func(c *FormatConfig) { c.NegativePrefix = prefix }
+}
+
+// WithNegativeSuffix sets FormatConfig.NegativeSuffix.
+// Example: New(-1050, USD).FormatOverride(WithNegativeSuffix(">")) -> "-$10.50>".
+func WithNegativeSuffix(suffix string) FormatOption {
+	return func(c *FormatConfig) { c.NegativeSuffix = suffix }
+}
+
+// WithRTL sets FormatConfig.RTL.
+// Example: New(-1050, ILS).FormatOverride(WithRTL(true)) -> "\u200e-₪\u200e10.50\u200e".
+func WithRTL(rtl bool) FormatOption {
+	return func(c *FormatConfig) { c.RTL = rtl }
+}
+
+// WithSuperscriptFraction sets FormatConfig.SuperscriptFraction.
+// Example: New(1050, USD).FormatOverride(WithSuperscriptFraction(true)) -> "$10⁵⁰".
+func WithSuperscriptFraction(superscript bool) FormatOption {
+	return func(c *FormatConfig) { c.SuperscriptFraction = superscript }
+}
+
+// WithScientific enables FormatConfig.Scientific from threshold major units.
+// Example: New(123456789000, USD).FormatOverride(WithScientific(1000000)) -> "$1.23e9".
+func WithScientific(threshold int64) FormatOption {
+	return func(c *FormatConfig) {
+		c.Scientific = true
+		c.ScientificThreshold = threshold
+	}
+}
+
+// WithSignificantDigits sets FormatConfig.SignificantDigits.
+// Example: New(123456789000, USD).FormatOverride(WithScientific(1000000), WithSignificantDigits(5)) -> "$1.2346e9".
+func WithSignificantDigits(n int) FormatOption {
+	return func(c *FormatConfig) { c.SignificantDigits = n }
+}
+
+// WithDebitCredit enables FormatConfig.DebitCredit; creditPositive sets
+// FormatConfig.CreditPositive.
+// Example: New(-1050, USD).FormatOverride(WithDebitCredit(false)) -> "$10.50 CR".
+func WithDebitCredit(creditPositive bool) FormatOption {
+	return func(c *FormatConfig) {
+		c.DebitCredit = true
+		c.CreditPositive = creditPositive
+	}
+}
+
+// WithSymbolPadWidth sets FormatConfig.SymbolPadWidth.
+// Example: New(1050, USD).FormatOverride(WithSymbolPadWidth(3)) -> "$  10.50".
+func WithSymbolPadWidth(width int) FormatOption {
+	return func(c *FormatConfig) { c.SymbolPadWidth = width }
+}
+
+// WithShowCode sets FormatConfig.ShowCode.
+// Example: New(1050, USD).FormatOverride(WithShowCode(true)) -> "USD $10.50".
+func WithShowCode(show bool) FormatOption {
+	return func(c *FormatConfig) { c.ShowCode = show }
+}
+
+// WithNumeralSystem sets FormatConfig.NumeralSystem.
+// Example: New(1050, USD).FormatOverride(WithNumeralSystem(NumeralsDevanagari)) -> "$१०.५०".
+func WithNumeralSystem(system NumeralSystem) FormatOption {
+	return func(c *FormatConfig) { c.NumeralSystem = system }
+}
+
+// WithMinDigitsForGrouping sets FormatConfig.MinDigitsForGrouping.
+// Example: New(123456, USD).FormatOverride(WithThousandsSeparator(","), WithMinDigitsForGrouping(5)) -> "$1234.56".
+func WithMinDigitsForGrouping(digits int) FormatOption {
+	return func(c *FormatConfig) { c.MinDigitsForGrouping = digits }
+}
+
+// WithVulgarFraction sets FormatConfig.VulgarFraction.
+// Example: New(1050, USD).FormatOverride(WithVulgarFraction(true)) -> "$10 50/100".
+func WithVulgarFraction(vulgar bool) FormatOption {
+	return func(c *FormatConfig) { c.VulgarFraction = vulgar }
+}
+
+// WithSymbolResolver sets FormatConfig.SymbolResolver.
+// Example: New(1050, USD).FormatOverride(WithSymbolResolver(&SymbolResolver{Func: pointsSymbol})) -> "pts10.50".
+func WithSymbolResolver(r *SymbolResolver) FormatOption {
+	return func(c *FormatConfig) { c.SymbolResolver = r }
+}
+
+// WithSymbolFunc sets FormatConfig.SymbolResolver to a new resolver calling fn.
+// Example: New(1050, USD).FormatOverride(WithSymbolFunc(func(c Currency) string { return c.Code + " " })) -> "USD 10.50".
+func WithSymbolFunc(fn func(Currency) string) FormatOption {
+	return WithSymbolResolver(&SymbolResolver{Func: fn})
+}
+
+// WithCodeCase sets FormatConfig.CodeCase.
+// Example: New(1050, USD).FormatOverride(WithSymbolCode(), WithCodeCase(CodeLower)) -> "usd10.50".
+func WithCodeCase(codeCase CodeCase) FormatOption {
+	return func(c *FormatConfig) { c.CodeCase = codeCase }
+}
+
+// WithMinDisplayScale sets FormatConfig.MinDisplayScale.
+// Example: New(123, JPY).FormatOverride(WithMinDisplayScale(2)) -> "¥123.00".
+func WithMinDisplayScale(digits int) FormatOption {
+	return func(c *FormatConfig) { c.MinDisplayScale = digits }
+}
@@ -0,0 +1,94 @@
+package money
+
+import "testing"
+
+func TestFormatOverride(t *testing.T) {
+	orig := DefaultFormat()
+	defer func() {
+		if err := SetFormat(orig); err != nil {
+			t.Fatalf("reset format: %v", err)
+		}
+	}()
+	if err := SetFormat(FormatConfig{
+		DecimalSeparator:   ",",
+		ThousandsSeparator: ".",
+		SymbolPosition:     SymbolSuffix,
+		SymbolKind:         SymbolUseCurrencySymbol,
+		Space:              true,
+	}); err != nil {
+		t.Fatalf("set format: %v", err)
+	}
+
+	eur := Currency{Code: "EUR", Scale: 2, Symbol: "€"}
+	m := New(123456, eur)
+
+	tests := []struct {
+		opts []FormatOption
+		want string
+	}{
+		{nil, "1.234,56 €"},
+		{[]FormatOption{WithSymbolCode()}, "1.234,56 EUR"},
+		{[]FormatOption{WithPrefix(), WithSpace(false)}, "€1.234,56"},
+		{[]FormatOption{WithCustomSymbol("euro"), WithThousandsSeparator("")}, "1234,56 euro"},
+		{[]FormatOption{WithDecimalSeparator("."), WithThousandsSeparator(","), WithMaxFractionDigits(1)}, "1,234.6 €"},
+		{[]FormatOption{WithSpace(false), WithSpace(true), WithSymbolKind(SymbolUseCurrencyCode)}, "1.234,56 EUR"},
+	}
+	for _, tt := range tests {
+		got, err := m.FormatOverride(tt.opts...)
+		if err != nil {
+			t.Fatalf("format override: %v", err)
+		}
+		if got != tt.want {
+			t.Fatalf("format override = %q, want %q", got, tt.want)
+		}
+	}
+
+	if _, err := m.FormatOverride(WithDecimalSeparator("")); err != ErrInvalidOperation {
+		t.Fatalf("expected ErrInvalidOperation, got %v", err)
+	}
+	if got := DefaultFormat(); got.SymbolKind != SymbolUseCurrencySymbol || !got.Space {
+		t.Fatalf("default format mutated: %+v", got)
+	}
+}
+
+func TestFormatOptionsSetFields(t *testing.T) {
+	var cfg FormatConfig
+	for _, opt := range []FormatOption{
+		WithNegativeParens(true),
+		WithMinIntegerDigits(3),
+		WithTrimTrailingZeros(true),
+		WithMinFractionDigits(1),
+		WithZeroText("-"),
+	} {
+		opt(&cfg)
+	}
+	want := FormatConfig{NegativeParens: true, MinIntegerDigits: 3, TrimTrailingZeros: true, MinFractionDigits: 1, ZeroText: "-"}
+	if cfg != want {
+		t.Fatalf("cfg = %+v, want %+v", cfg, want)
+	}
+}
+
+func TestFormatConfigWith(t *testing.T) {
+	base := USDFormat()
+	orig := base
+
+	got := base.With(WithSuffix(), WithSpace(true), WithSymbolCode(), WithSpace(false))
+	if base != orig {
+		t.Fatalf("With mutated receiver: %+v", base)
+	}
+	want := orig
+	want.SymbolPosition = SymbolSuffix
+	want.SymbolKind = SymbolUseCurrencyCode
+	if got != want {
+		t.Fatalf("With = %+v, want %+v", got, want)
+	}
+
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+	text, err := New(123456, usd).Format(base.With(WithSuffix(), WithSymbolCode(), WithSpace(true)))
+	if err != nil || text != "1,234.56 USD" {
+		t.Fatalf("format = %q, %v", text, err)
+	}
+	if base.With() != base {
+		t.Fatalf("With() without options changed the config")
+	}
+}
@@ -0,0 +1,271 @@
+package money
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+
+	"github.com/Opvra/go-money/internal/calc"
+)
+
+// Parse converts a plain decimal string in major units into Money.
+// The text must match [+-]digits[.digits] with at most currency.Scale fractional
+// digits; shorter fractions are zero-padded.
+// Example: Parse("10.5", USD) -> New(1050, USD).
+func Parse(s string, currency Currency) (Money, error) {
+	amount, err := calc.Parse(s, currency.Scale)
+	if err != nil {
+		return Money{}, ErrInvalidOperation
+	}
+	return Money{amount: amount, currency: currency}, nil
+}
+
+// ParseCompact parses the CompactString form, resolving the code via the registry.
+// Example: ParseCompact("USD-10.50") -> New(-1050, USD).
+func ParseCompact(s string) (Money, error) {
+	i := 0
+	for i < len(s) && s[i] >= 'A' && s[i] <= 'Z' {
+		i++
+	}
+	if i == 0 {
+		return Money{}, ErrInvalidOperation
+	}
+	currency, ok := LookupCurrency(s[:i])
+	if !ok {
+		return Money{}, ErrUnknownCurrency
+	}
+	return Parse(s[i:], currency)
+}
+
+// ParseDisplay parses a user-entered amount for the registered currency code.
+// A leading "-", the currency symbol or code (before or after the number) and
+// surrounding spaces are accepted; separators follow DefaultFormat, with
+// thousands separators removed before parsing.
+// Example: ParseDisplay("$1234.50", "USD") -> New(123450, USD).
+func ParseDisplay(s string, code string) (Money, error) {
+	currency, ok := LookupCurrency(code)
+	if !ok {
+		return Money{}, ErrUnknownCurrency
+	}
+	text := strings.TrimSpace(s)
+	sign := ""
+	if strings.HasPrefix(text, "-") {
+		sign, text = "-", text[1:]
+	}
+	for _, mark := range []string{currency.Symbol, currency.Code} {
+		if mark == "" {
+			continue
+		}
+		if trimmed, ok := strings.CutPrefix(text, mark); ok {
+			text = trimmed
+			break
+		}
+		if trimmed, ok := strings.CutSuffix(text, mark); ok {
+			text = trimmed
+			break
+		}
+	}
+	return parseSeparated(sign, text, currency, DefaultFormat())
+}
+
+// FromString parses an amount that names its currency with a three-letter
+// registered code before or after the number, such as "USD 10.50" or
+// "-10,50 EUR". Separators follow cfg when given and DefaultFormat otherwise;
+// more than one cfg returns ErrInvalidOperation. A missing code returns
+// ErrInvalidOperation and an unregistered one ErrUnknownCurrency.
+// Example: FromString("1.234,56 EUR", EUFormat()) -> New(123456, EUR).
+func FromString(s string, cfg ...FormatConfig) (Money, error) {
+	format := DefaultFormat()
+	switch len(cfg) {
+	case 0:
+	case 1:
+		if err := validateFormat(cfg[0]); err != nil {
+			return Money{}, err
+		}
+		format = cfg[0]
+	default:
+		return Money{}, ErrInvalidOperation
+	}
+	text := strings.TrimSpace(s)
+	sign := ""
+	if strings.HasPrefix(text, "-") {
+		sign, text = "-", text[1:]
+	}
+	code, rest, ok := cutCode(text)
+	if !ok {
+		return Money{}, ErrInvalidOperation
+	}
+	currency, ok := LookupCurrency(code)
+	if !ok {
+		return Money{}, ErrUnknownCurrency
+	}
+	return parseSeparated(sign, rest, currency, format)
+}
+
+// cutCode splits a leading or trailing run of exactly three ASCII capitals
+// from text.
+// Example: cutCode("USD 10.50") -> "USD", " 10.50", true.
+func cutCode(text string) (code, rest string, ok bool) {
+	upper := func(i int) bool { return i >= 0 && i < len(text) && text[i] >= 'A' && text[i] <= 'Z' }
+	n := len(text)
+	if n < 3 {
+		return "", "", false
+	}
+	if upper(0) && upper(1) && upper(2) && !upper(3) {
+		return text[:3], text[3:], true
+	}
+	if upper(n-3) && upper(n-2) && upper(n-1) && !upper(n-4) {
+		return text[n-3:], text[:n-3], true
+	}
+	return "", "", false
+}
+
+// parseSeparated parses text with cfg's separators once symbols are removed;
+// sign is a "-" already taken from the front of the input.
+// Example: parseSeparated("", "1.234,5", EUR, EUFormat()) -> New(123450, EUR).
+func parseSeparated(sign, text string, currency Currency, cfg FormatConfig) (Money, error) {
+	text = strings.TrimSpace(text)
+	if sign == "" && strings.HasPrefix(text, "-") {
+		sign, text = "-", text[1:]
+	}
+	if cfg.ThousandsSeparator != "" {
+		text = strings.ReplaceAll(text, cfg.ThousandsSeparator, "")
+	}
+	if cfg.DecimalSeparator != "." {
+		if strings.Contains(text, ".") {
+			return Money{}, ErrInvalidOperation
+		}
+		text = strings.ReplaceAll(text, cfg.DecimalSeparator, ".")
+	}
+	return Parse(sign+text, currency)
+}
+
+// ParseLines parses one Parse-style amount per line of r in the given currency.
+// Surrounding whitespace is trimmed and blank lines are skipped; the first
+// failure is reported with its 1-based line number and wraps ErrInvalidOperation.
+// Example: ParseLines(strings.NewReader("10.50\n-3\n"), USD) -> [New(1050, USD), New(-300, USD)].
+func ParseLines(r io.Reader, currency Currency) ([]Money, error) {
+	var out []Money
+	scanner := bufio.NewScanner(r)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+		m, err := Parse(text, currency)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %q: %w", line, text, err)
+		}
+		out = append(out, m)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ParseFlexible parses loosely typed input such as "$ 1,234.5", "1234,50 €" or
+// "USD 1000" for the given currency, rounding extra fraction digits half to even.
+//
+// The currency's symbol and code, whitespace and apostrophes are removed, and
+// a sign may lead, trail or be written as parentheses. The decimal separator is
+// then guessed: with both "." and "," present the last one is decimal and the
+// other groups thousands; a separator that repeats is grouping; a single
+// separator followed by 1-2 or 4+ digits is decimal. A lone separator followed
+// by exactly 3 digits, such as "1,234" or "1.234", is ambiguous and returns
+// ErrInvalidOperation, as do grouping layouts other than threes ("1,23,456").
+// Example: ParseFlexible("1.234,5 €", EUR) -> New(123450, EUR).
+func ParseFlexible(s string, currency Currency) (Money, error) {
+	text := s
+	for _, mark := range []string{currency.Code, currency.Symbol} {
+		if mark != "" {
+			text = strings.Replace(text, mark, "", 1)
+		}
+	}
+	text = strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) || r == '\'' || r == '’' {
+			return -1
+		}
+		return r
+	}, text)
+
+	negative := false
+	switch {
+	case strings.HasPrefix(text, "(") && strings.HasSuffix(text, ")"):
+		negative, text = true, text[1:len(text)-1]
+	case strings.HasPrefix(text, "-"):
+		negative, text = true, text[1:]
+	case strings.HasSuffix(text, "-"):
+		negative, text = true, text[:len(text)-1]
+	case strings.HasPrefix(text, "+"):
+		text = text[1:]
+	}
+
+	intPart, fracPart, err := splitFlexible(text)
+	if err != nil {
+		return Money{}, err
+	}
+	number := intPart
+	if fracPart != "" {
+		number += "." + fracPart
+	}
+	if negative {
+		number = "-" + number
+	}
+	amount, err := calc.ParseRound(number, currency.Scale)
+	if err != nil {
+		return Money{}, ErrInvalidOperation
+	}
+	return Money{amount: amount, currency: currency}, nil
+}
+
+// splitFlexible applies the ParseFlexible separator heuristic to unsigned digits.
+// Example: splitFlexible("1.234,5") -> "1234", "5".
+func splitFlexible(text string) (string, string, error) {
+	last := strings.LastIndexAny(text, ".,")
+	if last < 0 {
+		return text, "", nil
+	}
+	decimal, group := text[last:last+1], ","
+	if decimal == "," {
+		group = "."
+	}
+	head, tail := text[:last], text[last+1:]
+	if strings.Contains(head, decimal) {
+		// The separator repeats, so it can only be grouping.
+		digits, err := ungroup(text, decimal)
+		return digits, "", err
+	}
+	if tail == "" || (len(tail) == 3 && !strings.Contains(head, group)) {
+		return "", "", ErrInvalidOperation
+	}
+	if strings.Contains(head, group) {
+		var err error
+		if head, err = ungroup(head, group); err != nil {
+			return "", "", err
+		}
+	}
+	if head == "" {
+		head = "0"
+	}
+	return head, tail, nil
+}
+
+// ungroup validates three-digit grouping by sep and returns the bare digits.
+// Example: ungroup("1,234,567", ",") -> "1234567".
+func ungroup(text, sep string) (string, error) {
+	groups := strings.Split(text, sep)
+	if len(groups[0]) == 0 || len(groups[0]) > 3 {
+		return "", ErrInvalidOperation
+	}
+	for _, g := range groups[1:] {
+		if len(g) != 3 {
+			return "", ErrInvalidOperation
+		}
+	}
+	return strings.Join(groups, ""), nil
+}
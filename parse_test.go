@@ -0,0 +1,349 @@
+package money
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+	jpy := Currency{Code: "JPY", Scale: 0, Symbol: "¥"}
+
+	tests := []struct {
+		text     string
+		currency Currency
+		want     int64
+	}{
+		{"10.50", usd, 1050},
+		{"10.5", usd, 1050},
+		{"+10", usd, 1000},
+		{"-0.01", usd, -1},
+		{"-92233720368547758.08", usd, -9223372036854775808},
+		{"123", jpy, 123},
+	}
+	for _, tt := range tests {
+		got, err := Parse(tt.text, tt.currency)
+		if err != nil {
+			t.Fatalf("parse %q: %v", tt.text, err)
+		}
+		if !got.Equal(New(tt.want, tt.currency)) {
+			t.Fatalf("parse %q = %d, want %d", tt.text, got.Amount(), tt.want)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+	jpy := Currency{Code: "JPY", Scale: 0, Symbol: "¥"}
+
+	tests := []struct {
+		text     string
+		currency Currency
+	}{
+		{"", usd},
+		{"-", usd},
+		{".5", usd},
+		{"5.", usd},
+		{"1e3", usd},
+		{" 10", usd},
+		{"10.505", usd},
+		{"1.5", jpy},
+		{"92233720368547758.08", usd},
+	}
+	for _, tt := range tests {
+		if _, err := Parse(tt.text, tt.currency); err != ErrInvalidOperation {
+			t.Fatalf("parse %q error = %v", tt.text, err)
+		}
+	}
+}
+
+func FuzzParse(f *testing.F) {
+	for _, seed := range []string{"10.50", "-0.01", "+7", "1e3", ".5", "92233720368547758.08", "0.000"} {
+		f.Add(seed, int32(2))
+	}
+	cfg := FormatConfig{DecimalSeparator: ".", SymbolKind: SymbolUseCurrencySymbol}
+	f.Fuzz(func(t *testing.T, text string, scale int32) {
+		currency := Currency{Code: "XTS", Scale: scale}
+		m, err := Parse(text, currency)
+		if err != nil {
+			return
+		}
+		out, err := m.Format(cfg)
+		if err != nil {
+			t.Fatalf("format %d: %v", m.Amount(), err)
+		}
+		back, err := Parse(out, currency)
+		if err != nil {
+			t.Fatalf("reparse %q (from %q): %v", out, text, err)
+		}
+		if !back.Equal(m) {
+			t.Fatalf("round trip %q -> %q -> %d, want %d", text, out, back.Amount(), m.Amount())
+		}
+	})
+}
+
+func FuzzFormatParse(f *testing.F) {
+	f.Add(int64(1050), uint8(2))
+	f.Add(int64(-1), uint8(2))
+	f.Add(int64(-9223372036854775808), uint8(18))
+	f.Add(int64(123), uint8(0))
+	cfg := FormatConfig{DecimalSeparator: ".", SymbolKind: SymbolUseCurrencySymbol}
+	f.Fuzz(func(t *testing.T, amount int64, scale uint8) {
+		currency := Currency{Code: "XTS", Scale: int32(scale % 19)}
+		m := New(amount, currency)
+		out, err := m.Format(cfg)
+		if err != nil {
+			t.Fatalf("format %d: %v", amount, err)
+		}
+		back, err := Parse(out, currency)
+		if err != nil {
+			t.Fatalf("parse %q: %v", out, err)
+		}
+		if !back.Equal(m) {
+			t.Fatalf("round trip %d -> %q -> %d", amount, out, back.Amount())
+		}
+	})
+}
+
+func TestCompactRoundTrip(t *testing.T) {
+	usd, _ := LookupCurrency("USD")
+	jpy, _ := LookupCurrency("JPY")
+
+	tests := []struct {
+		m    Money
+		want string
+	}{
+		{New(1050, usd), "USD10.50"},
+		{New(-1050, usd), "USD-10.50"},
+		{New(5, usd), "USD0.05"},
+		{New(123, jpy), "JPY123"},
+		{New(-123, jpy), "JPY-123"},
+	}
+	for _, tt := range tests {
+		text := tt.m.CompactString()
+		if text != tt.want {
+			t.Fatalf("compact = %s, want %s", text, tt.want)
+		}
+		back, err := ParseCompact(text)
+		if err != nil {
+			t.Fatalf("parse compact %s: %v", text, err)
+		}
+		if !back.Equal(tt.m) {
+			t.Fatalf("round trip %s -> %v", text, back)
+		}
+	}
+}
+
+func TestParseCompactErrors(t *testing.T) {
+	tests := []struct {
+		text string
+		err  error
+	}{
+		{"10.50", ErrInvalidOperation},
+		{"XXQ10.50", ErrUnknownCurrency},
+		{"USD", ErrInvalidOperation},
+		{"USD 10.50", ErrInvalidOperation},
+		{"usd10.50", ErrInvalidOperation},
+	}
+	for _, tt := range tests {
+		if _, err := ParseCompact(tt.text); err != tt.err {
+			t.Fatalf("parse compact %q error = %v, want %v", tt.text, err, tt.err)
+		}
+	}
+}
+
+func TestParseDisplay(t *testing.T) {
+	tests := []struct {
+		text string
+		code string
+		want int64
+	}{
+		{"$10.50", "USD", 1050},
+		{"10.50", "USD", 1050},
+		{" $ 10.5 ", "USD", 1050},
+		{"-$10.50", "USD", -1050},
+		{"$-10.50", "USD", -1050},
+		{"10.50 USD", "USD", 1050},
+		{"¥123", "JPY", 123},
+	}
+	for _, tt := range tests {
+		got, err := ParseDisplay(tt.text, tt.code)
+		if err != nil {
+			t.Fatalf("parse display %q: %v", tt.text, err)
+		}
+		if got.Amount() != tt.want || got.Currency().Code != tt.code {
+			t.Fatalf("parse display %q = %v, want %d %s", tt.text, got, tt.want, tt.code)
+		}
+	}
+
+	if _, err := ParseDisplay("€10.50", "USD"); err != ErrInvalidOperation {
+		t.Fatalf("expected ErrInvalidOperation, got %v", err)
+	}
+	if _, err := ParseDisplay("10.50", "XXQ"); err != ErrUnknownCurrency {
+		t.Fatalf("expected ErrUnknownCurrency, got %v", err)
+	}
+}
+
+func TestParseDisplayLocaleSeparators(t *testing.T) {
+	orig := DefaultFormat()
+	defer func() {
+		if err := SetFormat(orig); err != nil {
+			t.Fatalf("reset format: %v", err)
+		}
+	}()
+	cfg, err := LocaleFormat("de-DE")
+	if err != nil {
+		t.Fatalf("locale: %v", err)
+	}
+	if err := SetFormat(cfg); err != nil {
+		t.Fatalf("set format: %v", err)
+	}
+
+	got, err := ParseDisplay("1.234,56 €", "EUR")
+	if err != nil {
+		t.Fatalf("parse display: %v", err)
+	}
+	if got.Amount() != 123456 {
+		t.Fatalf("amount = %d", got.Amount())
+	}
+	if _, err := ParseDisplay("1,234.56", "EUR"); err != ErrInvalidOperation {
+		t.Fatalf("expected ErrInvalidOperation, got %v", err)
+	}
+}
+
+func TestFromString(t *testing.T) {
+	usd, _ := LookupCurrency("USD")
+	eur, _ := LookupCurrency("EUR")
+	jpy, _ := LookupCurrency("JPY")
+
+	tests := []struct {
+		in   string
+		cfg  []FormatConfig
+		want Money
+	}{
+		{"USD 10.50", nil, New(1050, usd)},
+		{"USD10.5", nil, New(1050, usd)},
+		{"-USD 10.50", nil, New(-1050, usd)},
+		{"USD -10.50", nil, New(-1050, usd)},
+		{"10,50 EUR", []FormatConfig{EUFormat()}, New(1050, eur)},
+		{"EUR 1.234,56", []FormatConfig{EUFormat()}, New(123456, eur)},
+		{" 1,234 JPY ", []FormatConfig{USDFormat()}, New(1234, jpy)},
+	}
+	for _, tt := range tests {
+		got, err := FromString(tt.in, tt.cfg...)
+		if err != nil {
+			t.Fatalf("from string %q: %v", tt.in, err)
+		}
+		if !got.Equal(tt.want) {
+			t.Fatalf("from string %q = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+
+	errs := []struct {
+		in  string
+		cfg []FormatConfig
+		err error
+	}{
+		{"XXQ 10.50", nil, ErrUnknownCurrency},
+		{"10.50", nil, ErrInvalidOperation},
+		{"USDX 10.50", nil, ErrInvalidOperation},
+		{"USD 10.505", nil, ErrInvalidOperation},
+		{"EUR 1,234.56", []FormatConfig{EUFormat()}, ErrInvalidOperation},
+		{"USD 10.50", []FormatConfig{{}}, ErrInvalidOperation},
+		{"USD 10.50", []FormatConfig{USDFormat(), EUFormat()}, ErrInvalidOperation},
+	}
+	for _, tt := range errs {
+		if _, err := FromString(tt.in, tt.cfg...); err != tt.err {
+			t.Fatalf("from string %q error = %v, want %v", tt.in, err, tt.err)
+		}
+	}
+}
+
+func TestParseLines(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+
+	got, err := ParseLines(strings.NewReader("10.50\r\n  -3\n\n0.07\n"), usd)
+	if err != nil {
+		t.Fatalf("parse lines: %v", err)
+	}
+	want := []int64{1050, -300, 7}
+	if len(got) != len(want) {
+		t.Fatalf("parsed %d amounts, want %d", len(got), len(want))
+	}
+	for i, m := range got {
+		if m.Amount() != want[i] || m.Currency() != usd {
+			t.Fatalf("line amount %d = %v, want %d", i, m, want[i])
+		}
+	}
+
+	_, err = ParseLines(strings.NewReader("1.00\n2.00\n\n3.0x\n4.00\n"), usd)
+	if !errors.Is(err, ErrInvalidOperation) {
+		t.Fatalf("expected ErrInvalidOperation, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "line 4") {
+		t.Fatalf("error %q does not name line 4", err)
+	}
+
+	got, err = ParseLines(strings.NewReader(""), usd)
+	if err != nil || len(got) != 0 {
+		t.Fatalf("empty input = %v, %v", got, err)
+	}
+}
+
+func TestParseFlexible(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+	eur := Currency{Code: "EUR", Scale: 2, Symbol: "€"}
+	jpy := Currency{Code: "JPY", Scale: 0, Symbol: "¥"}
+
+	tests := []struct {
+		text     string
+		currency Currency
+		want     int64
+	}{
+		{"$ 1,234.5", usd, 123450},
+		{"1234,50 €", eur, 123450},
+		{"USD 1000", usd, 100000},
+		{"1.234,5 €", eur, 123450},
+		{"€1.234.567", eur, 123456700},
+		{"1,234,567.891", usd, 123456789},
+		{"-$12.3456", usd, -1235},
+		{"($1,000.00)", usd, -100000},
+		{"12.50-", usd, -1250},
+		{"+7", usd, 700},
+		{"1'234.50", usd, 123450},
+		{"1 234,56 €", eur, 123456},
+		{"0,5", eur, 50},
+		{",75", eur, 75},
+		{"10.0001", usd, 1000},
+		{"¥1,234,567", jpy, 1234567},
+		{"1.5", jpy, 2},
+	}
+	for _, tt := range tests {
+		got, err := ParseFlexible(tt.text, tt.currency)
+		if err != nil {
+			t.Fatalf("parse flexible %q: %v", tt.text, err)
+		}
+		if got.Amount() != tt.want || got.Currency() != tt.currency {
+			t.Fatalf("parse flexible %q = %d, want %d", tt.text, got.Amount(), tt.want)
+		}
+	}
+
+	for _, text := range []string{
+		"1,234",
+		"1.234",
+		"1,23,456",
+		"12,34.5",
+		"1.234.5",
+		"1234.",
+		"£10",
+		"",
+		"$",
+		"1e3",
+		"--5",
+	} {
+		if _, err := ParseFlexible(text, usd); err != ErrInvalidOperation {
+			t.Fatalf("parse flexible %q: expected ErrInvalidOperation, got %v", text, err)
+		}
+	}
+}
@@ -34,6 +34,17 @@ func (p Pipe) AddPercent(percent int64) Pipe {
 	return Pipe{money: m}
 }
 
+func (p Pipe) AddPercentOf(percent int64, base Money) Pipe {
+	if p.err != nil {
+		return p
+	}
+	m, err := p.money.AddPercentOf(percent, base)
+	if err != nil {
+		return Pipe{money: p.money, err: err}
+	}
+	return Pipe{money: m}
+}
+
 func (p Pipe) SubtractPercent(percent int64) Pipe {
 	if p.err != nil {
 		return p
@@ -45,6 +56,138 @@ func (p Pipe) SubtractPercent(percent int64) Pipe {
 	return Pipe{money: m}
 }
 
+func (p Pipe) AddPercentMode(percent int64, mode RoundingMode) Pipe {
+	if p.err != nil {
+		return p
+	}
+	m, err := p.money.AddPercentMode(percent, mode)
+	if err != nil {
+		return Pipe{money: p.money, err: err}
+	}
+	return Pipe{money: m}
+}
+
+func (p Pipe) SubtractPercentMode(percent int64, mode RoundingMode) Pipe {
+	if p.err != nil {
+		return p
+	}
+	m, err := p.money.SubtractPercentMode(percent, mode)
+	if err != nil {
+		return Pipe{money: p.money, err: err}
+	}
+	return Pipe{money: m}
+}
+
+func (p Pipe) AddPercentBankers(percent int64) Pipe {
+	if p.err != nil {
+		return p
+	}
+	m, err := p.money.AddPercentBankers(percent)
+	if err != nil {
+		return Pipe{money: p.money, err: err}
+	}
+	return Pipe{money: m}
+}
+
+func (p Pipe) SubtractPercentBankers(percent int64) Pipe {
+	if p.err != nil {
+		return p
+	}
+	m, err := p.money.SubtractPercentBankers(percent)
+	if err != nil {
+		return Pipe{money: p.money, err: err}
+	}
+	return Pipe{money: m}
+}
+
+func (p Pipe) SubtractPercentPoints(points ...int64) Pipe {
+	if p.err != nil {
+		return p
+	}
+	m, err := p.money.SubtractPercentPoints(points...)
+	if err != nil {
+		return Pipe{money: p.money, err: err}
+	}
+	return Pipe{money: m}
+}
+
+func (p Pipe) SubtractPercentCompound(percents ...int64) Pipe {
+	if p.err != nil {
+		return p
+	}
+	m, err := p.money.SubtractPercentCompound(percents...)
+	if err != nil {
+		return Pipe{money: p.money, err: err}
+	}
+	return Pipe{money: m}
+}
+
+func (p Pipe) SubtractPercentClamped(percent int64) Pipe {
+	if p.err != nil {
+		return p
+	}
+	m, err := p.money.SubtractPercentClamped(percent)
+	if err != nil {
+		return Pipe{money: p.money, err: err}
+	}
+	return Pipe{money: m}
+}
+
+func (p Pipe) SubtractPercentStrict(percent int64) Pipe {
+	if p.err != nil {
+		return p
+	}
+	m, err := p.money.SubtractPercentStrict(percent)
+	if err != nil {
+		return Pipe{money: p.money, err: err}
+	}
+	return Pipe{money: m}
+}
+
+func (p Pipe) GrowByPercent(percent int64, periods int, perPeriod bool) Pipe {
+	if p.err != nil {
+		return p
+	}
+	m, err := p.money.GrowByPercent(percent, periods, perPeriod)
+	if err != nil {
+		return Pipe{money: p.money, err: err}
+	}
+	return Pipe{money: m}
+}
+
+func (p Pipe) Abs() Pipe {
+	if p.err != nil {
+		return p
+	}
+	m, err := p.money.Abs()
+	if err != nil {
+		return Pipe{money: p.money, err: err}
+	}
+	return Pipe{money: m}
+}
+
+func (p Pipe) AbsDiff(x Money) Pipe {
+	if p.err != nil {
+		return p
+	}
+	m, err := p.money.AbsDiff(x)
+	if err != nil {
+		return Pipe{money: p.money, err: err}
+	}
+	return Pipe{money: m}
+}
+
+func (p Pipe) Negate() Pipe {
+	if p.err != nil {
+		return p
+	}
+	m, err := p.money.Negate()
+	if err != nil {
+		return Pipe{money: p.money, err: err}
+	}
+	return Pipe{money: m}
+}
+
 func (p Pipe) Mul(factor int64) Pipe {
 	if p.err != nil {
 		return p
@@ -66,3 +209,135 @@ func (p Pipe) Div(divisor int64) Pipe {
 	}
 	return Pipe{money: m}
 }
+
+func (p Pipe) DivMode(divisor int64, mode RoundingMode) Pipe {
+	if p.err != nil {
+		return p
+	}
+	m, err := p.money.DivMode(divisor, mode)
+	if err != nil {
+		return Pipe{money: p.money, err: err}
+	}
+	return Pipe{money: m}
+}
+
+func (p Pipe) DivToScale(divisor int64, scale int32) Pipe {
+	if p.err != nil {
+		return p
+	}
+	m, err := p.money.DivToScale(divisor, scale)
+	if err != nil {
+		return Pipe{money: p.money, err: err}
+	}
+	return Pipe{money: m}
+}
+
+func (p Pipe) DivBankers(divisor int64) Pipe {
+	if p.err != nil {
+		return p
+	}
+	m, err := p.money.DivBankers(divisor)
+	if err != nil {
+		return Pipe{money: p.money, err: err}
+	}
+	return Pipe{money: m}
+}
+
+func (p Pipe) RoundAwayFromZero(toScale int32) Pipe {
+	if p.err != nil {
+		return p
+	}
+	m, err := p.money.RoundAwayFromZero(toScale)
+	if err != nil {
+		return Pipe{money: p.money, err: err}
+	}
+	return Pipe{money: m}
+}
+
+func (p Pipe) RoundToCashDenomination() Pipe {
+	if p.err != nil {
+		return p
+	}
+	m, err := p.money.RoundToCashDenomination()
+	if err != nil {
+		return Pipe{money: p.money, err: err}
+	}
+	return Pipe{money: m}
+}
+
+func (p Pipe) Convert(rate ExchangeRate) Pipe {
+	if p.err != nil {
+		return p
+	}
+	m, err := p.money.Convert(rate)
+	if err != nil {
+		return Pipe{money: p.money, err: err}
+	}
+	return Pipe{money: m}
+}
+
+func (p Pipe) ConvertInverse(rate ExchangeRate) Pipe {
+	if p.err != nil {
+		return p
+	}
+	m, err := p.money.ConvertInverse(rate)
+	if err != nil {
+		return Pipe{money: p.money, err: err}
+	}
+	return Pipe{money: m}
+}
+
+func (p Pipe) ConvertChain(rates ...ExchangeRate) Pipe {
+	if p.err != nil {
+		return p
+	}
+	m, err := p.money.ConvertChain(rates...)
+	if err != nil {
+		return Pipe{money: p.money, err: err}
+	}
+	return Pipe{money: m}
+}
+
+func (p Pipe) Max(x Money) Pipe {
+	if p.err != nil {
+		return p
+	}
+	m, err := p.money.Max(x)
+	if err != nil {
+		return Pipe{money: p.money, err: err}
+	}
+	return Pipe{money: m}
+}
+
+func (p Pipe) Min(x Money) Pipe {
+	if p.err != nil {
+		return p
+	}
+	m, err := p.money.Min(x)
+	if err != nil {
+		return Pipe{money: p.money, err: err}
+	}
+	return Pipe{money: m}
+}
+
+func (p Pipe) ClampMin(lower Money) Pipe {
+	if p.err != nil {
+		return p
+	}
+	m, err := p.money.ClampMin(lower)
+	if err != nil {
+		return Pipe{money: p.money, err: err}
+	}
+	return Pipe{money: m}
+}
+
+func (p Pipe) ClampMax(upper Money) Pipe {
+	if p.err != nil {
+		return p
+	}
+	m, err := p.money.ClampMax(upper)
+	if err != nil {
+		return Pipe{money: p.money, err: err}
+	}
+	return Pipe{money: m}
+}
@@ -1,6 +1,9 @@
 package money
 
-import "testing"
+import (
+	"math"
+	"testing"
+)
 
 func TestPipeChain(t *testing.T) {
 	try := Currency{Code: "TRY", Scale: 2, Symbol: "₺"}
@@ -31,3 +34,24 @@ func TestPipeShortCircuit(t *testing.T) {
 		t.Fatalf("expected ErrCurrencyMismatch, got %v", err)
 	}
 }
+
+func TestPipeNegateAbs(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+
+	out, err := PipeOf(New(1050, usd)).
+		Negate().
+		Add(New(-50, usd)).
+		Abs().
+		Result()
+	if err != nil {
+		t.Fatalf("pipe error: %v", err)
+	}
+	if got := out.Amount(); got != 1100 {
+		t.Fatalf("amount = %d", got)
+	}
+
+	_, err = PipeOf(New(math.MinInt64, usd)).Abs().Negate().Result()
+	if err != ErrInvalidOperation {
+		t.Fatalf("expected ErrInvalidOperation, got %v", err)
+	}
+}
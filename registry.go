@@ -0,0 +1,85 @@
+package money
+
+import "sync"
+
+var registry = struct {
+	sync.RWMutex
+	currencies map[string]Currency
+	// cash holds the smallest cash increment in minor units where it is not 1.
+	cash map[string]int64
+}{
+	currencies: map[string]Currency{
+		"AUD": {Code: "AUD", Scale: 2, Symbol: "A$", MajorUnitName: "dollar", MinorUnitName: "cent"},
+		"BHD": {Code: "BHD", Scale: 3, Symbol: "BD"},
+		"CAD": {Code: "CAD", Scale: 2, Symbol: "CA$", MajorUnitName: "dollar", MinorUnitName: "cent"},
+		"CHF": {Code: "CHF", Scale: 2, Symbol: "CHF", MajorUnitName: "franc", MinorUnitName: "centime"},
+		"CNY": {Code: "CNY", Scale: 2, Symbol: "CN¥"},
+		"EUR": {Code: "EUR", Scale: 2, Symbol: "€", MajorUnitName: "euro", MinorUnitName: "cent"},
+		"GBP": {Code: "GBP", Scale: 2, Symbol: "£"},
+		"INR": {Code: "INR", Scale: 2, Symbol: "₹"},
+		"JPY": {Code: "JPY", Scale: 0, Symbol: "¥"},
+		"KRW": {Code: "KRW", Scale: 0, Symbol: "₩"},
+		"KWD": {Code: "KWD", Scale: 3, Symbol: "KD"},
+		"NOK": {Code: "NOK", Scale: 2, Symbol: "kr"},
+		"SEK": {Code: "SEK", Scale: 2, Symbol: "kr"},
+		"TRY": {Code: "TRY", Scale: 2, Symbol: "₺"},
+		"USD": {Code: "USD", Scale: 2, Symbol: "$", MajorUnitName: "dollar", MinorUnitName: "cent"},
+	},
+	cash: map[string]int64{
+		"AUD": 5,
+		"CAD": 5,
+		"CHF": 5,
+		"NOK": 100,
+		"SEK": 100,
+	},
+}
+
+// RegisterCurrency adds or replaces a currency in the package registry.
+// Example: RegisterCurrency(Currency{Code:"XPT", Scale:3}) makes LookupCurrency("XPT") succeed.
+func RegisterCurrency(c Currency) error {
+	if c.Code == "" || c.Scale < 0 {
+		return ErrInvalidOperation
+	}
+	registry.Lock()
+	defer registry.Unlock()
+	registry.currencies[c.Code] = c
+	return nil
+}
+
+// LookupCurrency returns the registered currency for an ISO-4217 code.
+// Example: LookupCurrency("JPY") -> Currency{Code:"JPY", Scale:0, Symbol:"¥"}, true.
+func LookupCurrency(code string) (Currency, bool) {
+	registry.RLock()
+	defer registry.RUnlock()
+	c, ok := registry.currencies[code]
+	return c, ok
+}
+
+// RegisterCashIncrement sets the smallest cash denomination of a currency code
+// in minor units; 1 removes any cash rounding.
+// Example: RegisterCashIncrement("DKK", 50) rounds cash kroner to 0.50.
+func RegisterCashIncrement(code string, increment int64) error {
+	if code == "" || increment <= 0 {
+		return ErrInvalidOperation
+	}
+	registry.Lock()
+	defer registry.Unlock()
+	if increment == 1 {
+		delete(registry.cash, code)
+		return nil
+	}
+	registry.cash[code] = increment
+	return nil
+}
+
+// CashIncrement returns the smallest cash denomination of a currency code in
+// minor units, or 1 when cash uses the full currency precision.
+// Example: CashIncrement("CHF") -> 5.
+func CashIncrement(code string) int64 {
+	registry.RLock()
+	defer registry.RUnlock()
+	if inc, ok := registry.cash[code]; ok {
+		return inc
+	}
+	return 1
+}
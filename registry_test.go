@@ -0,0 +1,87 @@
+package money
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRegistry(t *testing.T) {
+	jpy, ok := LookupCurrency("JPY")
+	if !ok || jpy.Scale != 0 || jpy.Symbol != "¥" {
+		t.Fatalf("lookup JPY = %+v, %v", jpy, ok)
+	}
+	if _, ok := LookupCurrency("XTS"); ok {
+		t.Fatalf("expected XTS to be unregistered")
+	}
+
+	xts := Currency{Code: "XTS", Scale: 4, Symbol: "T"}
+	if err := RegisterCurrency(xts); err != nil {
+		t.Fatalf("register: %v", err)
+	}
+	defer func() {
+		registry.Lock()
+		delete(registry.currencies, "XTS")
+		registry.Unlock()
+	}()
+	if got, ok := LookupCurrency("XTS"); !ok || got != xts {
+		t.Fatalf("lookup XTS = %+v, %v", got, ok)
+	}
+
+	if err := RegisterCurrency(Currency{Scale: 2}); err != ErrInvalidOperation {
+		t.Fatalf("expected ErrInvalidOperation, got %v", err)
+	}
+}
+
+func TestRoundToCashDenomination(t *testing.T) {
+	chf, _ := LookupCurrency("CHF")
+	usd, _ := LookupCurrency("USD")
+
+	tests := []struct {
+		m    Money
+		want int64
+	}{
+		{New(1023, chf), 1025},
+		{New(1022, chf), 1020},
+		{New(1027, chf), 1025},
+		{New(1028, chf), 1030},
+		{New(-1023, chf), -1025},
+		{New(1025, chf), 1025},
+		{New(1023, usd), 1023},
+	}
+	for _, tt := range tests {
+		got, err := tt.m.RoundToCashDenomination()
+		if err != nil {
+			t.Fatalf("round %d: %v", tt.m.Amount(), err)
+		}
+		if got.Amount() != tt.want || got.Currency() != tt.m.Currency() {
+			t.Fatalf("round %s %d = %d, want %d", tt.m.Currency().Code, tt.m.Amount(), got.Amount(), tt.want)
+		}
+	}
+
+	if err := RegisterCashIncrement("XTS", 50); err != nil {
+		t.Fatalf("register cash increment: %v", err)
+	}
+	defer func() {
+		if err := RegisterCashIncrement("XTS", 1); err != nil {
+			t.Fatalf("reset cash increment: %v", err)
+		}
+	}()
+	xts := Currency{Code: "XTS", Scale: 2}
+	// 0.25 is a tie between 0.00 and 0.50 and rounds to the even multiple.
+	for amount, want := range map[int64]int64{125: 100, 175: 200, 25: 0, 75: 100} {
+		got, err := New(amount, xts).RoundToCashDenomination()
+		if err != nil || got.Amount() != want {
+			t.Fatalf("round XTS %d = %d, %v; want %d", amount, got.Amount(), err, want)
+		}
+	}
+	if CashIncrement("XTS") != 50 || CashIncrement("USD") != 1 {
+		t.Fatalf("cash increments = %d, %d", CashIncrement("XTS"), CashIncrement("USD"))
+	}
+
+	if err := RegisterCashIncrement("XTS", 0); err != ErrInvalidOperation {
+		t.Fatalf("expected ErrInvalidOperation, got %v", err)
+	}
+	if _, err := New(math.MinInt64, chf).RoundToCashDenomination(); err != ErrInvalidOperation {
+		t.Fatalf("expected ErrInvalidOperation, got %v", err)
+	}
+}
@@ -0,0 +1,20 @@
+package money
+
+// RoundingMode selects how results are rounded to the currency scale.
+// Example: New(1001, USD).DivMode(2, RoundHalfUp) -> 501.
+type RoundingMode int32
+
+const (
+	// RoundHalfEven rounds to nearest, ties to even; it is the default for all operations.
+	RoundHalfEven RoundingMode = iota
+	// RoundHalfUp rounds to nearest, ties toward positive infinity (-2.5 -> -2).
+	RoundHalfUp
+	// RoundTowardZero truncates extra digits.
+	RoundTowardZero
+	// RoundFloor rounds toward negative infinity.
+	RoundFloor
+	// RoundCeiling rounds toward positive infinity.
+	RoundCeiling
+	// RoundHalfAwayFromZero rounds to nearest, ties away from zero (-2.5 -> -3).
+	RoundHalfAwayFromZero
+)
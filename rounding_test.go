@@ -0,0 +1,162 @@
+package money
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestDivMode(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+
+	tests := []struct {
+		amount  int64
+		divisor int64
+		want    [6]int64 // HalfEven, HalfUp, TowardZero, Floor, Ceiling, HalfAwayFromZero
+	}{
+		{1001, 2, [6]int64{500, 501, 500, 500, 501, 501}},
+		{1003, 2, [6]int64{502, 502, 501, 501, 502, 502}},
+		{-1001, 2, [6]int64{-500, -500, -500, -501, -500, -501}},
+		{-1003, 2, [6]int64{-502, -501, -501, -502, -501, -502}},
+		{1000, 3, [6]int64{333, 333, 333, 333, 334, 333}},
+		{1000, -3, [6]int64{-333, -333, -333, -334, -333, -333}},
+		{2000, 3, [6]int64{667, 667, 666, 666, 667, 667}},
+	}
+	modes := []RoundingMode{RoundHalfEven, RoundHalfUp, RoundTowardZero, RoundFloor, RoundCeiling, RoundHalfAwayFromZero}
+	for _, tt := range tests {
+		for i, mode := range modes {
+			out, err := New(tt.amount, usd).DivMode(tt.divisor, mode)
+			if err != nil {
+				t.Fatalf("div mode error: %v", err)
+			}
+			if got := out.Amount(); got != tt.want[i] {
+				t.Fatalf("%d / %d mode %d = %d, want %d", tt.amount, tt.divisor, mode, got, tt.want[i])
+			}
+		}
+	}
+
+	if _, err := New(1, usd).DivMode(0, RoundHalfUp); !errors.Is(err, ErrDivideByZero) {
+		t.Fatalf("expected ErrDivideByZero, got %v", err)
+	}
+	if _, err := New(1, usd).DivMode(3, RoundingMode(99)); err != ErrInvalidOperation {
+		t.Fatalf("expected ErrInvalidOperation, got %v", err)
+	}
+}
+
+func TestPercentMode(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+
+	tests := []struct {
+		amount  int64
+		percent int64
+		add     [6]int64 // HalfEven, HalfUp, TowardZero, Floor, Ceiling, HalfAwayFromZero
+		sub     [6]int64
+	}{
+		// 1015 * 1.10 = 1116.5, 1015 * 0.90 = 913.5
+		{1015, 10, [6]int64{1116, 1117, 1116, 1116, 1117, 1117}, [6]int64{914, 914, 913, 913, 914, 914}},
+		// -1015 * 1.10 = -1116.5, -1015 * 0.90 = -913.5
+		{-1015, 10, [6]int64{-1116, -1116, -1116, -1117, -1116, -1117}, [6]int64{-914, -913, -913, -914, -913, -914}},
+	}
+	modes := []RoundingMode{RoundHalfEven, RoundHalfUp, RoundTowardZero, RoundFloor, RoundCeiling, RoundHalfAwayFromZero}
+	for _, tt := range tests {
+		for i, mode := range modes {
+			add, err := New(tt.amount, usd).AddPercentMode(tt.percent, mode)
+			if err != nil {
+				t.Fatalf("add percent mode error: %v", err)
+			}
+			if got := add.Amount(); got != tt.add[i] {
+				t.Fatalf("%d +%d%% mode %d = %d, want %d", tt.amount, tt.percent, mode, got, tt.add[i])
+			}
+			sub, err := New(tt.amount, usd).SubtractPercentMode(tt.percent, mode)
+			if err != nil {
+				t.Fatalf("subtract percent mode error: %v", err)
+			}
+			if got := sub.Amount(); got != tt.sub[i] {
+				t.Fatalf("%d -%d%% mode %d = %d, want %d", tt.amount, tt.percent, mode, got, tt.sub[i])
+			}
+		}
+	}
+
+	def, err := New(1015, usd).AddPercent(10)
+	if err != nil {
+		t.Fatalf("add percent error: %v", err)
+	}
+	if def.Amount() != 1116 {
+		t.Fatalf("default add percent = %d, want half-even 1116", def.Amount())
+	}
+}
+
+func TestBankersRounding(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+
+	// Half-even and half-up only diverge when a tie lands next to an odd minor unit.
+	tests := []struct {
+		name   string
+		op     func(Money) (Money, error)
+		halfUp func(Money) (Money, error)
+		amount int64
+		want   int64
+		upWant int64
+	}{
+		{"tax 10% on 10.15", func(m Money) (Money, error) { return m.AddPercentBankers(10) },
+			func(m Money) (Money, error) { return m.AddPercentMode(10, RoundHalfUp) }, 1015, 1116, 1117},
+		{"tax 10% on 10.35", func(m Money) (Money, error) { return m.AddPercentBankers(10) },
+			func(m Money) (Money, error) { return m.AddPercentMode(10, RoundHalfUp) }, 1035, 1138, 1139},
+		{"tax 18% on 0.25", func(m Money) (Money, error) { return m.AddPercentBankers(18) },
+			func(m Money) (Money, error) { return m.AddPercentMode(18, RoundHalfUp) }, 25, 30, 30},
+		{"discount 10% on 10.15", func(m Money) (Money, error) { return m.SubtractPercentBankers(10) },
+			func(m Money) (Money, error) { return m.SubtractPercentMode(10, RoundHalfUp) }, 1015, 914, 914},
+		{"discount 10% on 10.25", func(m Money) (Money, error) { return m.SubtractPercentBankers(10) },
+			func(m Money) (Money, error) { return m.SubtractPercentMode(10, RoundHalfUp) }, 1025, 922, 923},
+		{"split 10.01 in two", func(m Money) (Money, error) { return m.DivBankers(2) },
+			func(m Money) (Money, error) { return m.DivMode(2, RoundHalfUp) }, 1001, 500, 501},
+	}
+	for _, tt := range tests {
+		got, err := tt.op(New(tt.amount, usd))
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		up, err := tt.halfUp(New(tt.amount, usd))
+		if err != nil {
+			t.Fatalf("%s half-up: %v", tt.name, err)
+		}
+		if got.Amount() != tt.want || up.Amount() != tt.upWant {
+			t.Fatalf("%s: bankers = %d, half-up = %d; want %d, %d", tt.name, got.Amount(), up.Amount(), tt.want, tt.upWant)
+		}
+	}
+}
+
+func TestRoundAwayFromZero(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+	bhd := Currency{Code: "BHD", Scale: 3}
+
+	tests := []struct {
+		m       Money
+		toScale int32
+		want    int64
+	}{
+		{New(250, usd), 0, 300},
+		{New(-250, usd), 0, -300},
+		{New(350, usd), 0, 400},
+		{New(-350, usd), 0, -400},
+		{New(249, usd), 0, 200},
+		{New(-249, usd), 0, -200},
+		{New(1005, bhd), 2, 1010},
+		{New(-1005, bhd), 2, -1010},
+		{New(-1004, bhd), 2, -1000},
+		{New(-1005, bhd), 3, -1005},
+		{New(-1005, bhd), 5, -1005},
+	}
+	for _, tt := range tests {
+		out, err := tt.m.RoundAwayFromZero(tt.toScale)
+		if err != nil {
+			t.Fatalf("round %d: %v", tt.m.Amount(), err)
+		}
+		if got := out.Amount(); got != tt.want {
+			t.Fatalf("round %d to scale %d = %d, want %d", tt.m.Amount(), tt.toScale, got, tt.want)
+		}
+	}
+
+	if _, err := New(1, usd).RoundAwayFromZero(-1); err != ErrInvalidOperation {
+		t.Fatalf("expected ErrInvalidOperation, got %v", err)
+	}
+}
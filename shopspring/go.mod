@@ -0,0 +1,12 @@
+module github.com/Opvra/go-money/shopspring
+
+go 1.22
+
+require (
+	github.com/Opvra/go-money v0.0.0
+	github.com/shopspring/decimal v1.4.0
+)
+
+require github.com/govalues/decimal v0.1.36 // indirect
+
+replace github.com/Opvra/go-money => ../
@@ -0,0 +1,21 @@
+// Package shopspring converts between money.Money and shopspring/decimal.
+// It lives in its own module so the core package does not depend on shopspring.
+package shopspring
+
+import (
+	money "github.com/Opvra/go-money"
+	"github.com/shopspring/decimal"
+)
+
+// ToShopspring returns the amount of m in major units as a shopspring decimal.
+// Example: ToShopspring(money.New(1050, USD)) -> decimal "10.5".
+func ToShopspring(m money.Money) decimal.Decimal {
+	return decimal.New(m.Amount(), -m.Currency().Scale)
+}
+
+// FromShopspring converts a major-unit decimal into Money of the given currency.
+// Extra fractional digits are rounded half to even, matching the core package.
+// Example: FromShopspring(decimal "10.505", USD) -> money.New(1050, USD).
+func FromShopspring(d decimal.Decimal, currency money.Currency) (money.Money, error) {
+	return money.Parse(d.RoundBank(currency.Scale).StringFixed(currency.Scale), currency)
+}
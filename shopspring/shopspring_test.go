@@ -0,0 +1,54 @@
+package shopspring
+
+import (
+	"testing"
+
+	money "github.com/Opvra/go-money"
+	"github.com/shopspring/decimal"
+)
+
+func TestRoundTrip(t *testing.T) {
+	usd := money.Currency{Code: "USD", Scale: 2, Symbol: "$"}
+	jpy := money.Currency{Code: "JPY", Scale: 0, Symbol: "¥"}
+
+	for _, m := range []money.Money{money.New(1050, usd), money.New(-1, usd), money.New(123, jpy)} {
+		back, err := FromShopspring(ToShopspring(m), m.Currency())
+		if err != nil {
+			t.Fatalf("from shopspring %v: %v", m, err)
+		}
+		if !back.Equal(m) {
+			t.Fatalf("round trip %v -> %v", m, back)
+		}
+	}
+	if got := ToShopspring(money.New(1050, usd)).String(); got != "10.5" {
+		t.Fatalf("to shopspring = %s", got)
+	}
+}
+
+func TestFromShopspringRounding(t *testing.T) {
+	usd := money.Currency{Code: "USD", Scale: 2, Symbol: "$"}
+
+	tests := []struct {
+		in   string
+		want int64
+	}{
+		{"10.505", 1050},
+		{"10.515", 1052},
+		{"10.5051", 1051},
+		{"-10.505", -1050},
+		{"-0.004", 0},
+	}
+	for _, tt := range tests {
+		got, err := FromShopspring(decimal.RequireFromString(tt.in), usd)
+		if err != nil {
+			t.Fatalf("from shopspring %s: %v", tt.in, err)
+		}
+		if got.Amount() != tt.want {
+			t.Fatalf("from shopspring %s = %d, want %d", tt.in, got.Amount(), tt.want)
+		}
+	}
+
+	if _, err := FromShopspring(decimal.RequireFromString("1e30"), usd); err != money.ErrInvalidOperation {
+		t.Fatalf("expected ErrInvalidOperation, got %v", err)
+	}
+}
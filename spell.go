@@ -0,0 +1,106 @@
+package money
+
+import (
+	"strings"
+
+	"github.com/Opvra/go-money/internal/calc"
+)
+
+var (
+	smallNumberWords = []string{
+		"zero", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine",
+		"ten", "eleven", "twelve", "thirteen", "fourteen", "fifteen", "sixteen",
+		"seventeen", "eighteen", "nineteen",
+	}
+	tensWords  = []string{"", "", "twenty", "thirty", "forty", "fifty", "sixty", "seventy", "eighty", "ninety"}
+	scaleWords = []string{"", "thousand", "million", "billion", "trillion", "quadrillion", "quintillion"}
+)
+
+// SpellOut renders the amount in English words, as printed on a check.
+// Unit names come from the currency, falling back to the registry entry for its
+// code; plurals add "s" and a zero minor part is omitted.
+// Example: New(1050, USD).SpellOut() -> "ten dollars and fifty cents".
+func (m Money) SpellOut() (string, error) {
+	major, minor := m.currency.MajorUnitName, m.currency.MinorUnitName
+	if major == "" {
+		registered, ok := LookupCurrency(m.currency.Code)
+		if !ok || registered.MajorUnitName == "" {
+			return "", ErrInvalidOperation
+		}
+		major, minor = registered.MajorUnitName, registered.MinorUnitName
+	}
+
+	abs := uint64(m.amount)
+	if m.amount < 0 {
+		abs = -abs
+	}
+	whole, frac := abs, uint64(0)
+	if m.currency.Scale > 0 {
+		unit, ok := calc.Pow10(m.currency.Scale)
+		if !ok {
+			return "", ErrInvalidOperation
+		}
+		whole, frac = abs/uint64(unit), abs%uint64(unit)
+		if frac != 0 && minor == "" {
+			return "", ErrInvalidOperation
+		}
+	}
+
+	out := spellUnits(whole, major)
+	if frac != 0 {
+		out += " and " + spellUnits(frac, minor)
+	}
+	if m.amount < 0 {
+		out = "minus " + out
+	}
+	return out, nil
+}
+
+// spellUnits renders n followed by the singular or plural unit name.
+// Example: spellUnits(1, "dollar") -> "one dollar".
+func spellUnits(n uint64, unit string) string {
+	if n != 1 {
+		unit += "s"
+	}
+	return spellNumber(n) + " " + unit
+}
+
+// spellNumber renders n in English words using short-scale names.
+// Example: spellNumber(1234) -> "one thousand two hundred thirty-four".
+func spellNumber(n uint64) string {
+	if n == 0 {
+		return smallNumberWords[0]
+	}
+	var groups []string
+	for i := 0; n > 0; i++ {
+		if chunk := n % 1000; chunk != 0 {
+			words := spellHundreds(chunk)
+			if scaleWords[i] != "" {
+				words += " " + scaleWords[i]
+			}
+			groups = append([]string{words}, groups...)
+		}
+		n /= 1000
+	}
+	return strings.Join(groups, " ")
+}
+
+// spellHundreds renders 1..999 in English words.
+// Example: spellHundreds(342) -> "three hundred forty-two".
+func spellHundreds(n uint64) string {
+	var parts []string
+	if n >= 100 {
+		parts = append(parts, smallNumberWords[n/100]+" hundred")
+		n %= 100
+	}
+	switch {
+	case n == 0:
+	case n < 20:
+		parts = append(parts, smallNumberWords[n])
+	case n%10 == 0:
+		parts = append(parts, tensWords[n/10])
+	default:
+		parts = append(parts, tensWords[n/10]+"-"+smallNumberWords[n%10])
+	}
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,41 @@
+package money
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSpellOut(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+	eur := Currency{Code: "EUR", Scale: 2, Symbol: "€", MajorUnitName: "euro", MinorUnitName: "cent"}
+
+	tests := []struct {
+		m    Money
+		want string
+	}{
+		{New(1050, usd), "ten dollars and fifty cents"},
+		{New(1000, usd), "ten dollars"},
+		{New(100, usd), "one dollar"},
+		{New(101, usd), "one dollar and one cent"},
+		{New(1, usd), "zero dollars and one cent"},
+		{New(0, usd), "zero dollars"},
+		{New(-2599, usd), "minus twenty-five dollars and ninety-nine cents"},
+		{New(123456789, usd), "one million two hundred thirty-four thousand five hundred sixty-seven dollars and eighty-nine cents"},
+		{New(100000000, eur), "one million euros"},
+		{New(math.MinInt64, Currency{Code: "XTS", Scale: 0, MajorUnitName: "point"}),
+			"minus nine quintillion two hundred twenty-three quadrillion three hundred seventy-two trillion thirty-six billion eight hundred fifty-four million seven hundred seventy-five thousand eight hundred eight points"},
+	}
+	for _, tt := range tests {
+		got, err := tt.m.SpellOut()
+		if err != nil {
+			t.Fatalf("spell out %d: %v", tt.m.Amount(), err)
+		}
+		if got != tt.want {
+			t.Fatalf("spell out %d = %q, want %q", tt.m.Amount(), got, tt.want)
+		}
+	}
+
+	if _, err := New(100, Currency{Code: "XTS", Scale: 2}).SpellOut(); err != ErrInvalidOperation {
+		t.Fatalf("expected ErrInvalidOperation, got %v", err)
+	}
+}
@@ -0,0 +1,61 @@
+package money
+
+import (
+	"strings"
+
+	"github.com/Opvra/go-money/internal/calc"
+)
+
+// Scan implements sql.Scanner for single-column money storage.
+// The destination must already carry its currency; Scan only fills the amount.
+// Supported sources:
+//   - int64: minor units, e.g. 1050.
+//   - string or []byte: a decimal in major units, optionally followed by a space
+//     and the currency code, e.g. "10.50" or "10.50 USD". The code must match the
+//     destination currency and at most Scale fractional digits are accepted.
+//     When Scale is positive the decimal point is required: "1050 USD" could
+//     mean minor units like int64 or major units, so it is rejected.
+//   - nil (SQL NULL): sets the amount to zero and keeps the currency.
+//
+// Example: m := Zero(USD); m.Scan("10.50 USD") -> m.Amount() == 1050.
+func (m *Money) Scan(src any) error {
+	if m.currency.Code == "" {
+		return ErrInvalidOperation
+	}
+	switch v := src.(type) {
+	case nil:
+		m.amount = 0
+		return nil
+	case int64:
+		m.amount = v
+		return nil
+	case string:
+		return m.scanText(v)
+	case []byte:
+		return m.scanText(string(v))
+	default:
+		return ErrInvalidOperation
+	}
+}
+
+func (m *Money) scanText(text string) error {
+	fields := strings.Fields(text)
+	switch len(fields) {
+	case 1:
+	case 2:
+		if fields[1] != m.currency.Code {
+			return ErrCurrencyMismatch
+		}
+	default:
+		return ErrInvalidOperation
+	}
+	if m.currency.Scale > 0 && !strings.Contains(fields[0], ".") {
+		return ErrInvalidOperation
+	}
+	amount, err := calc.Parse(fields[0], m.currency.Scale)
+	if err != nil {
+		return ErrInvalidOperation
+	}
+	m.amount = amount
+	return nil
+}
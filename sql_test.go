@@ -0,0 +1,62 @@
+package money
+
+import "testing"
+
+func TestScan(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+
+	tests := []struct {
+		src  any
+		want int64
+	}{
+		{"10.50", 1050},
+		{"10.5 USD", 1050},
+		{"-0.01", -1},
+		{[]byte("1050.00 USD"), 105000},
+		{int64(1050), 1050},
+		{nil, 0},
+	}
+	for _, tt := range tests {
+		m := New(999, usd)
+		if err := m.Scan(tt.src); err != nil {
+			t.Fatalf("scan %v: %v", tt.src, err)
+		}
+		if !m.Equal(New(tt.want, usd)) {
+			t.Fatalf("scan %v = %v, want %d", tt.src, m, tt.want)
+		}
+	}
+}
+
+func TestScanErrors(t *testing.T) {
+	usd := Currency{Code: "USD", Scale: 2, Symbol: "$"}
+
+	tests := []struct {
+		src any
+		err error
+	}{
+		{"10.50 EUR", ErrCurrencyMismatch},
+		{"10.505", ErrInvalidOperation},
+		{"1e3", ErrInvalidOperation},
+		{"10.50 USD extra", ErrInvalidOperation},
+		{"1050 USD", ErrInvalidOperation},
+		{[]byte("1050"), ErrInvalidOperation},
+		{"", ErrInvalidOperation},
+		{3.5, ErrInvalidOperation},
+	}
+	for _, tt := range tests {
+		m := Zero(usd)
+		if err := m.Scan(tt.src); err != tt.err {
+			t.Fatalf("scan %v error = %v, want %v", tt.src, err, tt.err)
+		}
+	}
+
+	jpy := New(1, Currency{Code: "JPY", Scale: 0, Symbol: "¥"})
+	if err := jpy.Scan("1050 JPY"); err != nil || jpy.Amount() != 1050 {
+		t.Fatalf("scan scale-0 integer = %v, %v", jpy, err)
+	}
+
+	var bare Money
+	if err := bare.Scan("10.50"); err != ErrInvalidOperation {
+		t.Fatalf("scan without currency error = %v", err)
+	}
+}
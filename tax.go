@@ -0,0 +1,43 @@
+package money
+
+import "github.com/Opvra/go-money/internal/calc"
+
+// TaxBreakdownInclusive splits a tax-inclusive price m into net, tax and gross
+// for a tax rate in basis points (1900 = 19%). The net amount is rounded half
+// to even and tax takes the rounding residual, so net+tax == gross == m exactly.
+// Example: New(11900, EUR).TaxBreakdownInclusive(1900) -> 10000, 1900, 11900.
+func (m Money) TaxBreakdownInclusive(rateBps int64) (net, tax, gross Money, err error) {
+	if rateBps < 0 {
+		return Money{}, Money{}, Money{}, ErrInvalidOperation
+	}
+	netAmount, err := calc.MulDiv(m.amount, 10000, 10000+rateBps)
+	if err != nil {
+		return Money{}, Money{}, Money{}, ErrInvalidOperation
+	}
+	net = Money{amount: netAmount, currency: m.currency}
+	tax, err = m.Sub(net)
+	if err != nil {
+		return Money{}, Money{}, Money{}, err
+	}
+	return net, tax, m, nil
+}
+
+// TaxBreakdownExclusive adds tax at rateBps basis points to a net price m and
+// returns net, tax and gross. Tax is rounded half to even and gross is net+tax
+// exactly.
+// Example: New(999, EUR).TaxBreakdownExclusive(1900) -> 999, 190, 1189.
+func (m Money) TaxBreakdownExclusive(rateBps int64) (net, tax, gross Money, err error) {
+	if rateBps < 0 {
+		return Money{}, Money{}, Money{}, ErrInvalidOperation
+	}
+	taxAmount, err := calc.MulDiv(m.amount, rateBps, 10000)
+	if err != nil {
+		return Money{}, Money{}, Money{}, ErrInvalidOperation
+	}
+	tax = Money{amount: taxAmount, currency: m.currency}
+	gross, err = m.Add(tax)
+	if err != nil {
+		return Money{}, Money{}, Money{}, err
+	}
+	return m, tax, gross, nil
+}
@@ -0,0 +1,88 @@
+package money
+
+import (
+	"math"
+	"testing"
+)
+
+func TestTaxBreakdownInclusive(t *testing.T) {
+	eur := Currency{Code: "EUR", Scale: 2, Symbol: "€"}
+
+	tests := []struct {
+		gross   int64
+		rate    int64
+		net     int64
+		wantTax int64
+	}{
+		{11900, 1900, 10000, 1900},
+		{999, 1900, 839, 160},
+		{100, 700, 93, 7},
+		{1, 2000, 1, 0},
+		{-11900, 1900, -10000, -1900},
+		{5000, 0, 5000, 0},
+	}
+	for _, tt := range tests {
+		net, tax, gross, err := New(tt.gross, eur).TaxBreakdownInclusive(tt.rate)
+		if err != nil {
+			t.Fatalf("inclusive %d at %d: %v", tt.gross, tt.rate, err)
+		}
+		if net.Amount() != tt.net || tax.Amount() != tt.wantTax || gross.Amount() != tt.gross {
+			t.Fatalf("inclusive %d at %d = %d + %d = %d, want %d + %d", tt.gross, tt.rate, net.Amount(), tax.Amount(), gross.Amount(), tt.net, tt.wantTax)
+		}
+	}
+}
+
+func TestTaxBreakdownExclusive(t *testing.T) {
+	eur := Currency{Code: "EUR", Scale: 2, Symbol: "€"}
+
+	tests := []struct {
+		net     int64
+		rate    int64
+		wantTax int64
+	}{
+		{10000, 1900, 1900},
+		{999, 1900, 190},
+		{250, 2000, 50},
+		{25, 1000, 2},
+		{35, 1000, 4},
+		{-999, 1900, -190},
+	}
+	for _, tt := range tests {
+		net, tax, gross, err := New(tt.net, eur).TaxBreakdownExclusive(tt.rate)
+		if err != nil {
+			t.Fatalf("exclusive %d at %d: %v", tt.net, tt.rate, err)
+		}
+		if net.Amount() != tt.net || tax.Amount() != tt.wantTax || gross.Amount() != tt.net+tt.wantTax {
+			t.Fatalf("exclusive %d at %d = %d + %d = %d, want tax %d", tt.net, tt.rate, net.Amount(), tax.Amount(), gross.Amount(), tt.wantTax)
+		}
+	}
+}
+
+func TestTaxBreakdownSumIdentity(t *testing.T) {
+	eur := Currency{Code: "EUR", Scale: 2, Symbol: "€"}
+	for _, rate := range []int64{0, 500, 700, 1900, 2000, 2100, 2500, 10000} {
+		for _, amount := range []int64{0, 1, 7, 99, 1001, 123457, -4321, math.MaxInt64 / 4} {
+			m := New(amount, eur)
+			for name, f := range map[string]func(int64) (Money, Money, Money, error){
+				"inclusive": m.TaxBreakdownInclusive,
+				"exclusive": m.TaxBreakdownExclusive,
+			} {
+				net, tax, gross, err := f(rate)
+				if err != nil {
+					t.Fatalf("%s %d at %d: %v", name, amount, rate, err)
+				}
+				sum, err := net.Add(tax)
+				if err != nil || !sum.Equal(gross) {
+					t.Fatalf("%s %d at %d: %d + %d != %d", name, amount, rate, net.Amount(), tax.Amount(), gross.Amount())
+				}
+			}
+		}
+	}
+
+	if _, _, _, err := New(100, eur).TaxBreakdownInclusive(-1); err != ErrInvalidOperation {
+		t.Fatalf("expected ErrInvalidOperation, got %v", err)
+	}
+	if _, _, _, err := New(math.MaxInt64, eur).TaxBreakdownExclusive(1900); err != ErrInvalidOperation {
+		t.Fatalf("expected ErrInvalidOperation, got %v", err)
+	}
+}